@@ -0,0 +1,48 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commentator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/eino/adk"
+
+	"github.com/ashwinyue/wolf-go-adk/params"
+	"github.com/ashwinyue/wolf-go-adk/utils"
+)
+
+// NewCommentatorAgent 创建解说员 Agent
+// 解说员没有任何工具，只根据主持人提供的上帝视角状态点评局势，输出不会发给玩家
+func NewCommentatorAgent(ctx context.Context, locale *params.Locale, modelCfg utils.ModelConfig) (adk.Agent, error) {
+	cm, err := utils.NewChatModel(ctx, modelCfg)
+	if err != nil {
+		return nil, fmt.Errorf("创建解说员 Agent 失败: %w", err)
+	}
+
+	agent, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
+		Name:          "Commentator",
+		Description:   "狼人杀解说员，面向观众点评每个阶段的局势",
+		Instruction:   locale.Prompts.CommentatorSystem,
+		Model:         cm,
+		MaxIterations: 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("创建解说员 Agent 失败: %w", err)
+	}
+	return agent, nil
+}
@@ -21,13 +21,41 @@ import (
 	"fmt"
 
 	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/components/tool"
 
 	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+	"github.com/ashwinyue/wolf-go-adk/tools"
+	"github.com/ashwinyue/wolf-go-adk/utils"
 )
 
+// Options 玩家 Agent 的配置：使用的模型和可选工具，未启用的工具不会出现在玩家的工具列表中
+type Options struct {
+	Model utils.ModelConfig // 玩家使用的模型
+
+	StructuredSpeech bool // 白天发言时可以调用 speech 工具提交结构化内容
+	TrackBeliefs     bool // 投票前可以调用 belief 工具提交概率判断
+	SheriffOrder     bool // 当选警长后可以调用 direction 工具决定发言方向
+}
+
+// optionalTools 返回按配置启用的可选工具
+func (o Options) optionalTools(name string, state *game.GameState, locale *params.Locale) []tool.BaseTool {
+	var result []tool.BaseTool
+	if o.StructuredSpeech {
+		result = append(result, tools.NewSpeechTool(name, state, locale))
+	}
+	if o.TrackBeliefs {
+		result = append(result, tools.NewBeliefTool(name, state, locale))
+	}
+	if o.SheriffOrder {
+		result = append(result, tools.NewDirectionTool(locale))
+	}
+	return result
+}
+
 // CreatePlayerAgents 创建所有玩家 Agent
 // 每个玩家都是独立的 ChatModelAgent，有自己的 ReAct 循环
-func CreatePlayerAgents(ctx context.Context, state *game.GameState) (map[string]adk.Agent, error) {
+func CreatePlayerAgents(ctx context.Context, state *game.GameState, locale *params.Locale, opts Options) (map[string]adk.Agent, error) {
 	playerAgents := make(map[string]adk.Agent)
 
 	for name, player := range state.Players {
@@ -35,18 +63,18 @@ func CreatePlayerAgents(ctx context.Context, state *game.GameState) (map[string]
 		var err error
 
 		switch player.Role {
-		case game.RoleWerewolf:
-			agent, err = NewWerewolfAgent(ctx, name, state)
+		case game.RoleWerewolf, game.RoleHiddenWolf:
+			agent, err = NewWerewolfAgent(ctx, name, state, locale, opts)
 		case game.RoleVillager:
-			agent, err = NewVillagerAgent(ctx, name, state)
+			agent, err = NewVillagerAgent(ctx, name, state, locale, opts)
 		case game.RoleSeer:
-			agent, err = NewSeerAgent(ctx, name, state)
+			agent, err = NewSeerAgent(ctx, name, state, locale, opts)
 		case game.RoleWitch:
-			agent, err = NewWitchAgent(ctx, name, state)
+			agent, err = NewWitchAgent(ctx, name, state, locale, opts)
 		case game.RoleHunter:
-			agent, err = NewHunterAgent(ctx, name, state)
+			agent, err = NewHunterAgent(ctx, name, state, locale, opts)
 		default:
-			agent, err = NewVillagerAgent(ctx, name, state)
+			agent, err = NewVillagerAgent(ctx, name, state, locale, opts)
 		}
 
 		if err != nil {
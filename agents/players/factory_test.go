@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package players
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+func TestOptionalTools(t *testing.T) {
+	state := game.NewGameState()
+	state.InitPlayers([]string{"Player1", "Player2"}, []game.Role{game.RoleWerewolf, game.RoleVillager})
+	locale := params.NewLocale("en")
+
+	tests := []struct {
+		name string
+		opts Options
+		want []string
+	}{
+		{"默认不附加", Options{}, nil},
+		{"结构化发言", Options{StructuredSpeech: true}, []string{"speech"}},
+		{"概率判断", Options{TrackBeliefs: true}, []string{"belief"}},
+		{"警长决定发言方向", Options{SheriffOrder: true}, []string{"direction"}},
+		{"全部启用", Options{StructuredSpeech: true, TrackBeliefs: true, SheriffOrder: true}, []string{"speech", "belief", "direction"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, bt := range tt.opts.optionalTools("Player2", state, locale) {
+				info, err := bt.Info(context.Background())
+				if err != nil {
+					t.Fatalf("读取工具定义失败: %v", err)
+				}
+				got = append(got, info.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("optionalTools(%+v) = %v，期望 %v", tt.opts, got, tt.want)
+			}
+		})
+	}
+}
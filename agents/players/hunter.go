@@ -31,20 +31,26 @@ import (
 )
 
 // NewHunterAgent 创建猎人 Agent
-func NewHunterAgent(ctx context.Context, name string, state *game.GameState) (adk.Agent, error) {
-	instruction := params.BuildPlayerInstruction(name, game.RoleHunter)
+func NewHunterAgent(ctx context.Context, name string, state *game.GameState, locale *params.Locale, opts Options) (adk.Agent, error) {
+	instruction := locale.BuildPlayerInstruction(name, game.RoleHunter)
 
-	// 猎人工具：开枪、投票
+	// 猎人工具：开枪、投票，以及按配置启用的可选工具
 	playerTools := []tool.BaseTool{
-		tools.NewShootTool(state),
-		tools.NewVoteTool(state),
+		tools.NewShootTool(name, state, locale),
+		tools.NewVoteTool(name, state, locale),
+	}
+	playerTools = append(playerTools, opts.optionalTools(name, state, locale)...)
+
+	cm, err := utils.NewChatModel(ctx, opts.Model)
+	if err != nil {
+		return nil, fmt.Errorf("创建猎人 Agent %s 失败: %w", name, err)
 	}
 
 	agent, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
 		Name:        name,
 		Description: fmt.Sprintf("玩家 %s，角色：猎人", name),
 		Instruction: instruction,
-		Model:       utils.MustNewChatModel(ctx),
+		Model:       cm,
 		ToolsConfig: adk.ToolsConfig{
 			ToolsNodeConfig: compose.ToolsNodeConfig{
 				Tools: playerTools,
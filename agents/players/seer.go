@@ -31,20 +31,26 @@ import (
 )
 
 // NewSeerAgent 创建预言家 Agent
-func NewSeerAgent(ctx context.Context, name string, state *game.GameState) (adk.Agent, error) {
-	instruction := params.BuildPlayerInstruction(name, game.RoleSeer)
+func NewSeerAgent(ctx context.Context, name string, state *game.GameState, locale *params.Locale, opts Options) (adk.Agent, error) {
+	instruction := locale.BuildPlayerInstruction(name, game.RoleSeer)
 
-	// 预言家工具：查验、投票
+	// 预言家工具：查验、投票，以及按配置启用的可选工具
 	playerTools := []tool.BaseTool{
-		tools.NewCheckTool(state),
-		tools.NewVoteTool(state),
+		tools.NewCheckTool(name, state, locale),
+		tools.NewVoteTool(name, state, locale),
+	}
+	playerTools = append(playerTools, opts.optionalTools(name, state, locale)...)
+
+	cm, err := utils.NewChatModel(ctx, opts.Model)
+	if err != nil {
+		return nil, fmt.Errorf("创建预言家 Agent %s 失败: %w", name, err)
 	}
 
 	agent, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
 		Name:        name,
 		Description: fmt.Sprintf("玩家 %s，角色：预言家", name),
 		Instruction: instruction,
-		Model:       utils.MustNewChatModel(ctx),
+		Model:       cm,
 		ToolsConfig: adk.ToolsConfig{
 			ToolsNodeConfig: compose.ToolsNodeConfig{
 				Tools: playerTools,
@@ -31,19 +31,25 @@ import (
 )
 
 // NewVillagerAgent 创建村民 Agent
-func NewVillagerAgent(ctx context.Context, name string, state *game.GameState) (adk.Agent, error) {
-	instruction := params.BuildPlayerInstruction(name, game.RoleVillager)
+func NewVillagerAgent(ctx context.Context, name string, state *game.GameState, locale *params.Locale, opts Options) (adk.Agent, error) {
+	instruction := locale.BuildPlayerInstruction(name, game.RoleVillager)
 
-	// 村民工具：投票
+	// 村民工具：投票，以及按配置启用的可选工具
 	playerTools := []tool.BaseTool{
-		tools.NewVoteTool(state),
+		tools.NewVoteTool(name, state, locale),
+	}
+	playerTools = append(playerTools, opts.optionalTools(name, state, locale)...)
+
+	cm, err := utils.NewChatModel(ctx, opts.Model)
+	if err != nil {
+		return nil, fmt.Errorf("创建村民 Agent %s 失败: %w", name, err)
 	}
 
 	agent, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
 		Name:        name,
 		Description: fmt.Sprintf("玩家 %s，角色：村民", name),
 		Instruction: instruction,
-		Model:       utils.MustNewChatModel(ctx),
+		Model:       cm,
 		ToolsConfig: adk.ToolsConfig{
 			ToolsNodeConfig: compose.ToolsNodeConfig{
 				Tools: playerTools,
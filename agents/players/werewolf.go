@@ -30,22 +30,28 @@ import (
 	"github.com/ashwinyue/wolf-go-adk/utils"
 )
 
-// NewWerewolfAgent 创建狼人 Agent
-func NewWerewolfAgent(ctx context.Context, name string, state *game.GameState) (adk.Agent, error) {
-	instruction := params.BuildPlayerInstruction(name, game.RoleWerewolf)
+// NewWerewolfAgent 创建狼人 Agent，隐狼使用相同的工具，只是角色指导不同
+func NewWerewolfAgent(ctx context.Context, name string, state *game.GameState, locale *params.Locale, opts Options) (adk.Agent, error) {
+	instruction := locale.BuildPlayerInstruction(name, state.GetPlayerRole(name))
 
-	// 狼人工具：讨论、击杀、投票
+	// 狼人工具：讨论、击杀、投票，以及按配置启用的可选工具
 	playerTools := []tool.BaseTool{
-		tools.NewDiscussTool(),
-		tools.NewKillTool(state),
-		tools.NewVoteTool(state),
+		tools.NewDiscussTool(state, locale),
+		tools.NewKillTool(state, locale),
+		tools.NewVoteTool(name, state, locale),
+	}
+	playerTools = append(playerTools, opts.optionalTools(name, state, locale)...)
+
+	cm, err := utils.NewChatModel(ctx, opts.Model)
+	if err != nil {
+		return nil, fmt.Errorf("创建狼人 Agent %s 失败: %w", name, err)
 	}
 
 	agent, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
 		Name:        name,
 		Description: fmt.Sprintf("玩家 %s，角色：狼人", name),
 		Instruction: instruction,
-		Model:       utils.MustNewChatModel(ctx),
+		Model:       cm,
 		ToolsConfig: adk.ToolsConfig{
 			ToolsNodeConfig: compose.ToolsNodeConfig{
 				Tools: playerTools,
@@ -31,21 +31,27 @@ import (
 )
 
 // NewWitchAgent 创建女巫 Agent
-func NewWitchAgent(ctx context.Context, name string, state *game.GameState) (adk.Agent, error) {
-	instruction := params.BuildPlayerInstruction(name, game.RoleWitch)
+func NewWitchAgent(ctx context.Context, name string, state *game.GameState, locale *params.Locale, opts Options) (adk.Agent, error) {
+	instruction := locale.BuildPlayerInstruction(name, game.RoleWitch)
 
-	// 女巫工具：救人、毒人、投票
+	// 女巫工具：救人、毒人、投票，以及按配置启用的可选工具
 	playerTools := []tool.BaseTool{
-		tools.NewSaveTool(state),
-		tools.NewPoisonTool(state),
-		tools.NewVoteTool(state),
+		tools.NewSaveTool(name, state, locale),
+		tools.NewPoisonTool(name, state, locale),
+		tools.NewVoteTool(name, state, locale),
+	}
+	playerTools = append(playerTools, opts.optionalTools(name, state, locale)...)
+
+	cm, err := utils.NewChatModel(ctx, opts.Model)
+	if err != nil {
+		return nil, fmt.Errorf("创建女巫 Agent %s 失败: %w", name, err)
 	}
 
 	agent, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
 		Name:        name,
 		Description: fmt.Sprintf("玩家 %s，角色：女巫", name),
 		Instruction: instruction,
-		Model:       utils.MustNewChatModel(ctx),
+		Model:       cm,
 		ToolsConfig: adk.ToolsConfig{
 			ToolsNodeConfig: compose.ToolsNodeConfig{
 				Tools: playerTools,
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/params"
+	"github.com/ashwinyue/wolf-go-adk/utils"
+)
+
+// receives 判断玩家当前是否接收公开消息：存活玩家总是接收，出局玩家只在 observe 策略下继续接收
+func (m *ModeratorAgent) receives(player string) bool {
+	return m.state.IsAlive(player) || m.board.DeadPolicy() == params.DeadObserve
+}
+
+// deliver 把公开消息交给接收消息的玩家，spectate 策略下出局玩家错过的消息留到反思前补发，调用方需持有 m.mu
+func (m *ModeratorAgent) deliver(player, content string) {
+	if !m.receives(player) {
+		if m.board.DeadPolicy() == params.DeadSpectate {
+			m.missed[player] = append(m.missed[player], content)
+		}
+		return
+	}
+	msg := &schema.Message{Role: schema.User, Content: content}
+	m.playerMsgs[player] = append(m.playerMsgs[player], msg)
+	m.recordTranscript(player, msg)
+}
+
+// catchUp 把出局玩家错过的公开消息整理成一条摘要追加到其消息历史
+func (m *ModeratorAgent) catchUp(player string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	missed := m.missed[player]
+	if len(missed) == 0 {
+		return
+	}
+	lines := make([]string, len(missed))
+	for i, content := range missed {
+		lines[i] = "- " + utils.Truncate(content, 200)
+	}
+	msg := &schema.Message{
+		Role:    schema.User,
+		Content: fmt.Sprintf(m.locale.Prompts.ToDeadCatchUp, len(missed), strings.Join(lines, "\n")),
+	}
+	m.playerMsgs[player] = append(m.playerMsgs[player], msg)
+	m.recordTranscript(player, msg)
+	delete(m.missed, player)
+}
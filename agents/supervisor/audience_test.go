@@ -0,0 +1,103 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/memory"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+func TestDeadSeatPolicies(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleVillager, game.RoleSeer, game.RoleVillager}
+	const public = "[Player1]: 我是好人"
+
+	tests := []struct {
+		policy  params.DeadSeatPolicy
+		live    bool // 出局后是否立即收到公开消息
+		catchUp bool // 反思前是否收到出局后的摘要
+	}{
+		{"", true, false}, // 默认与 observe 相同
+		{params.DeadObserve, true, false},
+		{params.DeadSpectate, false, true},
+		{params.DeadSilent, false, false},
+	}
+	for _, tt := range tests {
+		name := string(tt.policy)
+		if name == "" {
+			name = "default"
+		}
+		t.Run(name, func(t *testing.T) {
+			board := params.DefaultBoard
+			board.DeadSeats = tt.policy
+			m := newTestModerator(t, board, roles, map[string][]string{"Player2": {"经验：多听发言"}})
+			m.model = "test-model"
+			m.memory = memory.NewStore(t.TempDir())
+			m.recordLessons = true
+
+			m.state.KillPlayer("Player2")
+			m.broadcastToAll(public)
+
+			if got := hasMessage(m, "Player2", public); got != tt.live {
+				t.Errorf("出局玩家收到公开消息 = %v，期望 %v", got, tt.live)
+			}
+			if !hasMessage(m, "Player1", public) {
+				t.Error("存活玩家没有收到公开消息")
+			}
+
+			drive(func(gen *adkGen) { m.playerReflection(context.Background(), gen) })
+
+			summary := strings.SplitN(m.locale.Prompts.ToDeadCatchUp, "%", 2)[0]
+			if got := hasMessage(m, "Player2", summary); got != tt.catchUp {
+				t.Errorf("出局玩家收到摘要 = %v，期望 %v", got, tt.catchUp)
+			}
+			if !tt.live && !tt.catchUp && hasMessage(m, "Player2", public) {
+				t.Error("silent 策略下出局玩家看到了出局后的公开消息")
+			}
+
+			prompts := m.playerAgents["Player2"].(*scriptedAgent).prompts
+			if !slices.Contains(prompts, m.locale.Prompts.ToAllReflect) {
+				t.Errorf("出局玩家没有收到反思提示，收到 %v", prompts)
+			}
+			lessons, err := m.memory.Lessons("test-model")
+			if err != nil {
+				t.Fatalf("读取经验库失败: %v", err)
+			}
+			// 任何策略下出局玩家的反思都要写入经验库
+			if !slices.ContainsFunc(lessons, func(l memory.Lesson) bool { return l.Player == "Player2" }) {
+				t.Errorf("出局玩家没有记录经验，经验库中有 %v", lessons)
+			}
+		})
+	}
+}
+
+// hasMessage 判断玩家的消息历史中是否有包含 text 的消息
+func hasMessage(m *ModeratorAgent, player, text string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, msg := range m.playerMsgs[player] {
+		if strings.Contains(msg.Content, text) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/eino/adk"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+// publishClaim 记录并返回玩家本次公开发言附带的结构化内容，玩家没有调用 speech 工具时返回 nil
+func (m *ModeratorAgent) publishClaim(player string) *game.SpeechClaim {
+	claim, ok := m.state.PublishClaim(player)
+	if !ok {
+		return nil
+	}
+	m.logger.LogClaim(player, claim)
+	return &claim
+}
+
+// announceClaims 投票前向所有玩家汇总公开的结构化声明，并提示对跳
+func (m *ModeratorAgent) announceClaims(gen *adk.AsyncGenerator[*adk.AgentEvent]) {
+	summary := m.claimsSummary()
+	if summary == "" {
+		return
+	}
+	m.broadcastToAll(fmt.Sprintf(m.locale.Prompts.ToAllClaims, summary))
+	m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.ClaimsSummary, summary))
+	m.logger.LogModerator(fmt.Sprintf(m.locale.I18n.ClaimsSummary, summary))
+}
+
+// claimsSummary 按玩家汇总结构化声明：身份取最近一次声明，查验结果累计，怀疑和信任取最近一次
+func (m *ModeratorAgent) claimsSummary() string {
+	claims := m.state.PublicClaims()
+	if len(claims) == 0 {
+		return ""
+	}
+
+	merged := make(map[string]*game.SpeechClaim)
+	var players []string
+	for _, c := range claims {
+		mc, ok := merged[c.Player]
+		if !ok {
+			mc = &game.SpeechClaim{}
+			merged[c.Player] = mc
+			players = append(players, c.Player)
+		}
+		if c.Claim.Role != "" {
+			mc.Role = c.Claim.Role
+		}
+		for _, check := range c.Claim.Checks {
+			if !containsCheck(mc.Checks, check) {
+				mc.Checks = append(mc.Checks, check)
+			}
+		}
+		if len(c.Claim.Suspects) > 0 {
+			mc.Suspects = c.Claim.Suspects
+		}
+		if len(c.Claim.Trusted) > 0 {
+			mc.Trusted = c.Claim.Trusted
+		}
+	}
+
+	t := m.locale.I18n
+	var lines []string
+	for _, player := range players {
+		c := merged[player]
+		var parts []string
+		if c.Role != "" {
+			parts = append(parts, fmt.Sprintf(t.ClaimRole, m.roleName(c.Role)))
+		}
+		for _, check := range c.Checks {
+			result := t.ClaimGood
+			if check.IsWolf {
+				result = m.roleName(game.RoleWerewolf)
+			}
+			parts = append(parts, fmt.Sprintf(t.ClaimCheck, check.Target, result))
+		}
+		if len(c.Suspects) > 0 {
+			parts = append(parts, fmt.Sprintf(t.ClaimSuspects, strings.Join(c.Suspects, ", ")))
+		}
+		if len(c.Trusted) > 0 {
+			parts = append(parts, fmt.Sprintf(t.ClaimTrusted, strings.Join(c.Trusted, ", ")))
+		}
+		if len(parts) > 0 {
+			lines = append(lines, fmt.Sprintf("- %s: %s", player, strings.Join(parts, "; ")))
+		}
+	}
+
+	// 同一身份有多名存活玩家声明即为对跳（村民和狼人除外）
+	claimants := m.state.RoleClaimants()
+	for _, role := range []game.Role{game.RoleSeer, game.RoleWitch, game.RoleHunter} {
+		var alive []string
+		for _, p := range claimants[role] {
+			if m.state.IsAlive(p) {
+				alive = append(alive, p)
+			}
+		}
+		if len(alive) > 1 {
+			lines = append(lines, fmt.Sprintf(t.CounterClaim, strings.Join(alive, ", "), m.roleName(role)))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func containsCheck(checks []game.ClaimedCheck, check game.ClaimedCheck) bool {
+	for _, c := range checks {
+		if c == check {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+// commentate 阶段结束后请解说员点评，结果只发往观众频道（Commentator 事件和 commentary.md）
+// 解说员拥有上帝视角，因此其输出绝不写入任何玩家的消息历史
+func (m *ModeratorAgent) commentate(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], phase string) {
+	if m.commentator == nil {
+		return
+	}
+
+	events := m.logger.Events()
+	var lines []string
+	for _, e := range events {
+		if e.Seq <= m.commentarySeq {
+			continue
+		}
+		if line := describeEvent(e); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(events) > 0 {
+		m.commentarySeq = events[len(events)-1].Seq
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	prompt := fmt.Sprintf(m.locale.Prompts.ToCommentator, phase, m.godView(), strings.Join(lines, "\n"))
+	m.commentaryMsgs = append(m.commentaryMsgs, &schema.Message{Role: schema.User, Content: prompt})
+
+	iter := m.commentator.Run(ctx, &adk.AgentInput{Messages: m.commentaryMsgs})
+	var response string
+	for {
+		event, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if event.Err != nil {
+			fmt.Printf("  "+m.locale.I18n.Error+"\n", "Commentator", event.Err)
+			continue
+		}
+		if event.Output != nil && event.Output.MessageOutput != nil {
+			if msg := event.Output.MessageOutput.Message; msg != nil && msg.Content != "" {
+				response = msg.Content
+			}
+		}
+	}
+	if response == "" {
+		return
+	}
+	m.commentaryMsgs = append(m.commentaryMsgs, &schema.Message{Role: schema.Assistant, Content: response})
+
+	gen.Send(&adk.AgentEvent{
+		AgentName: "Commentator",
+		Output: &adk.AgentOutput{
+			MessageOutput: &adk.MessageVariant{
+				Message: &schema.Message{
+					Role:    schema.Assistant,
+					Content: fmt.Sprintf(m.locale.I18n.Commentary, response),
+				},
+				Role: schema.Assistant,
+			},
+		},
+	})
+	m.logger.LogCommentary(phase, response)
+}
+
+// godView 上帝视角的场上状态：每名玩家的真实身份和存活情况、女巫药水以及公开声明
+func (m *ModeratorAgent) godView() string {
+	var sb strings.Builder
+	for _, name := range m.state.Seats {
+		player := m.state.Players[name]
+		status := m.locale.I18n.StatusAlive
+		if !player.Alive {
+			status = m.locale.I18n.StatusDead
+		}
+		sb.WriteString(fmt.Sprintf("- %s: %s (%s)\n", name, m.roleName(player.Role), status))
+	}
+	sb.WriteString(fmt.Sprintf("- %s: healing=%t, poison=%t\n", m.roleName(game.RoleWitch), m.state.HealingPotion, m.state.PoisonPotion))
+	if claims := m.claimsSummary(); claims != "" {
+		sb.WriteString(claims + "\n")
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// describeEvent 把结构化事件压缩成一行供解说员阅读，主持人流程消息和解说本身不计入
+func describeEvent(e game.Event) string {
+	switch e.Type {
+	case game.EventModeratorMsg, game.EventCommentary, game.EventRound, game.EventPhase, game.EventGameStart, game.EventBelief, game.EventClaim:
+		return ""
+	}
+	line := string(e.Type)
+	if e.Action != "" {
+		line += " " + string(e.Action)
+	}
+	if e.Actor != "" {
+		line += " " + e.Actor
+	}
+	if e.Target != "" {
+		line += " -> " + e.Target
+	}
+	if e.Content != "" {
+		line += ": " + e.Content
+	}
+	if e.Winner != "" {
+		line += ": " + string(e.Winner)
+	}
+	return line
+}
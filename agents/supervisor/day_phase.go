@@ -18,47 +18,41 @@ package supervisor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cloudwego/eino/adk"
 
 	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/memory"
 	"github.com/ashwinyue/wolf-go-adk/params"
-	"github.com/ashwinyue/wolf-go-adk/tools"
 	"github.com/ashwinyue/wolf-go-adk/utils"
 )
 
 // dayPhase 白天阶段
 func (m *ModeratorAgent) dayPhase(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent]) {
-	m.sendMessage(gen, "\n--- ☀️ 白天阶段 ---")
-	m.state.Phase = "day"
-	m.logger.LogPhase("☀️ 白天阶段")
+	m.sendMessage(gen, "\n"+m.locale.I18n.DayPhase)
+	m.state.SetPhase("day")
+	m.logger.LogPhase(m.locale.I18n.PhaseDay)
 
-	// 公布夜间死亡
-	var dead []string
-	if m.state.NightKilled != "" && !m.state.NightSaved {
-		dead = append(dead, m.state.NightKilled)
-	}
-	if m.state.NightPoisoned != "" {
-		dead = append(dead, m.state.NightPoisoned)
-	}
-	if m.state.NightShot != "" {
-		dead = append(dead, m.state.NightShot)
-	}
+	// 公布夜间死亡，使用夜晚结算时去重后的名单（例如被狼刀又被毒的玩家只公布一次）
+	dead := m.nightDead
+	m.nightDead = nil
 
 	if len(dead) > 0 {
-		announcement := fmt.Sprintf(params.Prompts.ToAllDay, strings.Join(dead, ", "))
+		announcement := fmt.Sprintf(m.locale.Prompts.ToAllDay, strings.Join(dead, ", "))
 		m.broadcastToAll(announcement) // 广播给所有玩家
-		m.sendMessage(gen, fmt.Sprintf("  📢 %s", announcement))
-		m.logger.LogModerator(fmt.Sprintf("昨晚 %s 被淘汰了。", strings.Join(dead, ", ")))
+		m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.Announcement, announcement))
+		m.logger.LogModerator(fmt.Sprintf(m.locale.I18n.ModDeaths, strings.Join(dead, ", ")))
 
 		// 猎人开枪消息
 		if m.state.NightShot != "" {
-			hunterMsg := fmt.Sprintf(params.Prompts.ToAllHunterShoot, m.state.NightShot)
+			hunterMsg := fmt.Sprintf(m.locale.Prompts.ToAllHunterShoot, m.state.NightShot)
 			m.broadcastToAll(hunterMsg)
-			m.sendMessage(gen, fmt.Sprintf("  📢 %s", hunterMsg))
+			m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.Announcement, hunterMsg))
 		}
 
 		// 第一晚死者遗言
@@ -66,9 +60,9 @@ func (m *ModeratorAgent) dayPhase(ctx context.Context, gen *adk.AsyncGenerator[*
 			m.lastWords(ctx, gen, m.state.NightKilled)
 		}
 	} else {
-		m.broadcastToAll(params.Prompts.ToAllPeace)
-		m.sendMessage(gen, fmt.Sprintf("  📢 %s", params.Prompts.ToAllPeace))
-		m.logger.LogModerator("昨晚是平安夜，没有人被淘汰。")
+		m.broadcastToAll(m.locale.Prompts.ToAllPeace)
+		m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.Announcement, m.locale.Prompts.ToAllPeace))
+		m.logger.LogModerator(m.locale.I18n.ModPeace)
 	}
 
 	// 检查胜利条件
@@ -76,91 +70,149 @@ func (m *ModeratorAgent) dayPhase(ctx context.Context, gen *adk.AsyncGenerator[*
 		return
 	}
 
+	// 夜里出局的警长移交警徽
+	m.passBadge(ctx, gen)
+
 	alivePlayers := m.state.GetAlivePlayers()
-	m.sendMessage(gen, fmt.Sprintf("  📢 存活玩家: %s", strings.Join(alivePlayers, ", ")))
+	m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.AlivePlayers, strings.Join(alivePlayers, ", ")))
+
+	// 按警长决定发言顺序的板子在第一个白天竞选警长
+	if m.board.Discussion.Order == params.OrderSheriff && m.state.FirstDay {
+		m.electSheriff(ctx, gen, alivePlayers)
+	}
 
 	// 1. 讨论阶段
 	m.discussPhase(ctx, gen, alivePlayers)
 
-	// 2. 投票阶段
+	// 2. 投票前汇总结构化声明
+	m.announceClaims(gen)
+
+	// 3. 记录概率判断（可选）
+	if m.trackBeliefs {
+		m.collectBeliefs(ctx, alivePlayers)
+	}
+
+	// 4. 投票阶段
 	m.votePhase(ctx, gen, alivePlayers)
+
+	// 被放逐或被猎人带走的警长移交警徽
+	if winner := m.state.CheckWinner(); winner == "" {
+		m.passBadge(ctx, gen)
+	}
 }
 
 // discussPhase 讨论阶段
 func (m *ModeratorAgent) discussPhase(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], alivePlayers []string) {
-	m.sendMessage(gen, "  💬 讨论阶段:")
-	m.logger.LogPhase("💬 讨论阶段")
-	m.logger.LogModerator("现在进入讨论阶段，请各位玩家依次发言。")
+	m.sendMessage(gen, "  "+m.locale.I18n.DiscussionPhase)
+	m.logger.LogPhase(m.locale.I18n.PhaseDiscussion)
+	m.logger.LogModerator(m.locale.I18n.ModDiscussStart)
+
+	cfg := m.board.Discussion
+	order := m.speakingOrder(ctx, gen, cfg.Order)
 
 	// 广播讨论开始
-	discussMsg := fmt.Sprintf(params.Prompts.ToAllDiscuss, strings.Join(alivePlayers, ", "), strings.Join(alivePlayers, ", "))
+	discussMsg := fmt.Sprintf(m.locale.Prompts.ToAllDiscuss, strings.Join(alivePlayers, ", "), strings.Join(order, ", "))
 	m.broadcastToAll(discussMsg)
 
-	for _, player := range alivePlayers {
-		query := "轮到你发言了，请分析局势并表达你的观点。"
-
-		response := m.callPlayer(ctx, player, query)
-		if response != "" {
-			m.sendMessage(gen, fmt.Sprintf("  [%s]: %s", player, utils.Truncate(response, 200)))
-			// 广播给所有人
-			m.broadcastToAll(fmt.Sprintf("[%s]: %s", player, response))
-			m.logger.LogDiscussion(player, response)
+	rounds := cfg.Rounds
+	if rounds < 1 {
+		rounds = 1
+	}
+
+	// 记录所有发言，供反驳轮提取指控
+	var speeches []speech
+	for round := 1; round <= rounds; round++ {
+		if rounds > 1 {
+			m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.DiscussionRound, round, strings.Join(order, ", ")))
+			m.broadcastToAll(fmt.Sprintf(m.locale.Prompts.ToAllDiscussRound, round, strings.Join(order, ", ")))
+		}
+
+		if cfg.Parallel {
+			speeches = append(speeches, m.parallelSpeeches(ctx, gen, order)...)
+		} else {
+			speeches = append(speeches, m.sequentialSpeeches(ctx, gen, order)...)
+		}
+
+		// 随机顺序每轮重新洗牌
+		if cfg.Order == params.OrderRandom && round < rounds {
+			order = m.speakingOrder(ctx, gen, cfg.Order)
 		}
 	}
+
+	if cfg.Rebuttal {
+		m.rebuttalRound(ctx, gen, order, speeches)
+	}
 }
 
 // votePhase 投票阶段
 func (m *ModeratorAgent) votePhase(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], alivePlayers []string) {
-	m.sendMessage(gen, "  🗳️ 投票阶段:")
-	m.logger.LogPhase("🗳️ 投票阶段")
-	m.logger.LogModerator("讨论结束，现在进入投票阶段，请投票选出你认为的狼人。")
+	m.sendMessage(gen, "  "+m.locale.I18n.VotingPhase)
+	m.logger.LogPhase(m.locale.I18n.PhaseVote)
+	m.logger.LogModerator(m.locale.I18n.ModVoteStart)
 
 	votes := make(map[string]string)
+	abstained := make(map[string]bool)
+	spoiled := make(map[string]bool)
 	var wg sync.WaitGroup
 	var mu sync.Mutex
 
-	// 创建投票工具
-	voteTool := tools.NewVoteTool(m.state)
-
 	for _, player := range alivePlayers {
 		wg.Add(1)
 		go func(p string) {
 			defer wg.Done()
 
-			query := fmt.Sprintf(params.Prompts.ToAllVote, strings.Join(alivePlayers, ", "))
+			query := fmt.Sprintf(m.locale.Prompts.ToAllVote, strings.Join(alivePlayers, ", "))
 
-			var target string
-			if voteTool != nil {
-				result, err := m.callPlayerWithTool(ctx, p, query, voteTool)
-				if err == nil {
-					if t, ok := result["target"].(string); ok {
-						target = t
-					}
-				}
-			}
-
-			if target != "" && target != p {
-				mu.Lock()
+			target, err := m.decideTarget(ctx, gen, p, game.ActionVote, query, pickBallot)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case target != "":
 				votes[p] = target
 				m.logger.LogVote(p, target)
-				mu.Unlock()
-				m.sendMessage(gen, fmt.Sprintf("  [%s] 投票: %s", p, target))
+				m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.PlayerVotes, p, target))
+			case err == nil:
+				// 玩家明确弃票
+				abstained[p] = true
+				m.logger.LogAbstain(p)
+				m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.PlayerAbstains, p))
+			default:
+				// 没有回复或多次选择不合法的目标
+				reason, last := game.SpoiledNoResponse, ""
+				var illegal *game.TargetError
+				if errors.As(err, &illegal) {
+					reason, last = string(illegal.Reason), illegal.Target
+				}
+				spoiled[p] = true
+				m.logger.LogSpoiled(p, last, reason)
+				m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.PlayerSpoiled, p, m.locale.I18n.Log.SpoiledReason(reason)))
 			}
 		}(player)
 	}
 	wg.Wait()
 
-	if len(votes) == 0 {
-		m.sendMessage(gen, "  ➡️ 无有效投票")
-		m.logger.LogVoteResult("", "无有效投票")
+	// 平票时用本局随机源从最高票中抽取出局者，相同种子的对局结果相同
+	votedOut, tied, tally := utils.MajorityVote(votes, alivePlayers, m.rng)
+	details := m.ballotDetails(tally, alivePlayers, abstained, spoiled)
+
+	// 有效票不足时无人出局
+	required := m.board.QuorumVotes(len(alivePlayers))
+	if len(votes) < required {
+		m.broadcastToAll(fmt.Sprintf(m.locale.Prompts.ToAllResNone, details, len(votes), required))
+		if len(votes) == 0 {
+			m.sendMessage(gen, "  ➡️ "+m.locale.I18n.NoValidVotes)
+		} else {
+			m.sendMessage(gen, "  ➡️ "+fmt.Sprintf(m.locale.I18n.VoteNoQuorum, len(votes), len(alivePlayers), required, details))
+		}
+		m.logger.LogVoteResult("", details)
 		return
 	}
 
-	votedOut, details := utils.MajorityVote(votes)
 	// 广播投票结果
-	voteResultMsg := fmt.Sprintf(params.Prompts.ToAllRes, details, votedOut)
+	details += m.tieNote(tied, votedOut)
+	voteResultMsg := fmt.Sprintf(m.locale.Prompts.ToAllRes, details, votedOut)
 	m.broadcastToAll(voteResultMsg)
-	m.sendMessage(gen, fmt.Sprintf("  ➡️ 投票结果: %s 被淘汰 (%s)", votedOut, details))
+	m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.VoteResult, votedOut, details))
 	m.logger.LogVoteResult(votedOut, details)
 
 	if votedOut != "" {
@@ -178,18 +230,74 @@ func (m *ModeratorAgent) votePhase(ctx context.Context, gen *adk.AsyncGenerator[
 	}
 }
 
+// tieNote 最高票并列时返回附在票型后的说明，没有平票时返回空字符串
+func (m *ModeratorAgent) tieNote(tied []string, winner string) string {
+	if len(tied) == 0 {
+		return ""
+	}
+	return "; " + fmt.Sprintf(m.locale.Prompts.ToVoteTie, strings.Join(tied, ", "), winner)
+}
+
+// ballotDetails 在票型后按座位顺序附上弃票和废票的玩家
+func (m *ModeratorAgent) ballotDetails(tally string, alivePlayers []string, abstained, spoiled map[string]bool) string {
+	var abstainers, spoilers []string
+	for _, p := range alivePlayers {
+		if abstained[p] {
+			abstainers = append(abstainers, p)
+		}
+		if spoiled[p] {
+			spoilers = append(spoilers, p)
+		}
+	}
+
+	var parts []string
+	if tally != "" {
+		parts = append(parts, tally)
+	}
+	if len(abstainers) > 0 {
+		parts = append(parts, fmt.Sprintf(m.locale.Prompts.ToVoteAbstain, strings.Join(abstainers, ", ")))
+	}
+	if len(spoilers) > 0 {
+		parts = append(parts, fmt.Sprintf(m.locale.Prompts.ToVoteSpoiled, strings.Join(spoilers, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// collectBeliefs 私下要求每名存活玩家提交对其他玩家是狼人的概率判断
+func (m *ModeratorAgent) collectBeliefs(ctx context.Context, alivePlayers []string) {
+	var wg sync.WaitGroup
+	for _, player := range alivePlayers {
+		var others []string
+		for _, p := range alivePlayers {
+			if p != player {
+				others = append(others, p)
+			}
+		}
+
+		wg.Add(1)
+		go func(p string, others []string) {
+			defer wg.Done()
+			m.callPlayer(ctx, p, fmt.Sprintf(m.locale.Prompts.ToBelief, strings.Join(others, ", ")))
+		}(player, others)
+	}
+	wg.Wait()
+}
+
 // lastWords 遗言
 func (m *ModeratorAgent) lastWords(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], player string) {
-	query := fmt.Sprintf(params.Prompts.ToDeadPlayer, player)
+	query := fmt.Sprintf(m.locale.Prompts.ToDeadPlayer, player)
 	// 广播遗言提示
 	m.broadcastToAll(query)
 
-	response := m.callPlayer(ctx, player, query)
+	response, streamed := m.speak(ctx, gen, player, query)
 	if response != "" {
-		m.sendMessage(gen, fmt.Sprintf("  [%s] (遗言): %s", player, utils.Truncate(response, 200)))
+		if !streamed {
+			m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.LastWords, player, utils.Truncate(response, 200)))
+		}
 		// 遗言广播给所有人
-		m.broadcastToAll(fmt.Sprintf("[%s 遗言]: %s", player, response))
+		m.broadcastToAll(fmt.Sprintf(m.locale.Prompts.ToAllLastWords, player, response))
 		m.logger.LogLastWords(player, response)
+		m.publishClaim(player)
 	}
 }
 
@@ -200,49 +308,86 @@ func (m *ModeratorAgent) hunterShoot(ctx context.Context, gen *adk.AsyncGenerato
 		return
 	}
 
-	promptText := fmt.Sprintf(params.Prompts.ToHunter, hunter)
-
-	// 使用结构化工具
-	shootTool := tools.NewShootTool(m.state)
-	if shootTool != nil {
-		result, err := m.callPlayerWithTool(ctx, hunter, promptText, shootTool)
-		if err == nil {
-			if shoot, ok := result["shoot"].(bool); ok && shoot {
-				if target, ok := result["target"].(string); ok && target != "" {
-					m.state.KillPlayer(target)
-					// 广播猎人开枪消息
-					m.broadcastToAll(fmt.Sprintf(params.Prompts.ToAllHunterShoot, target))
-					m.sendMessage(gen, fmt.Sprintf("  🔫 猎人射杀了 %s！", target))
-					m.logger.LogHunterShoot(target)
-					return
-				}
-			}
-		}
+	promptText := fmt.Sprintf(m.locale.Prompts.ToHunter, hunter)
+
+	if target, _ := m.decideTarget(ctx, gen, hunter, game.ActionShoot, promptText, pickIf("shoot")); target != "" {
+		m.state.KillPlayer(target)
+		// 广播猎人开枪消息
+		m.broadcastToAll(fmt.Sprintf(m.locale.Prompts.ToAllHunterShoot, target))
+		m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.HunterShot, target))
+		m.logger.LogHunterShoot(target)
 	}
 }
 
 // playerReflection 玩家反思
 func (m *ModeratorAgent) playerReflection(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent]) {
-	m.sendMessage(gen, "\n=== 🎭 玩家反思 ===")
+	m.sendMessage(gen, "\n"+m.locale.I18n.PlayerReflections)
 
 	var wg sync.WaitGroup
 	var mu sync.Mutex
+	// 按座位记录经验，写入经验库的顺序不受各玩家回复先后影响
+	seatLessons := make([]*memory.Lesson, len(m.state.Seats))
 
-	for name := range m.state.Players {
+	policy := m.board.DeadPolicy()
+	for i, name := range m.state.Seats {
 		wg.Add(1)
-		go func(playerName string) {
+		go func(i int, playerName string) {
 			defer wg.Done()
 
-			response := m.callPlayer(ctx, playerName, params.Prompts.ToAllReflect)
+			// 出局玩家同样反思：spectate 先补看出局后的摘要，silent 只凭出局前的记忆
+			if policy == params.DeadSpectate {
+				m.catchUp(playerName)
+			}
+			response := m.callPlayer(ctx, playerName, m.locale.Prompts.ToAllReflect)
 
 			if response != "" {
 				mu.Lock()
 				role := string(m.state.GetPlayerRole(playerName))
-				m.sendMessage(gen, fmt.Sprintf("  [%s] 反思: %s", playerName, utils.Truncate(response, 200)))
+				m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.Reflection, playerName, utils.Truncate(response, 200)))
 				m.logger.LogReflection(playerName, role, response)
+				if text := m.extractLesson(response); text != "" {
+					seatLessons[i] = &memory.Lesson{
+						Model:  m.model,
+						Role:   game.Role(role),
+						GameID: m.logger.GameID(),
+						Player: playerName,
+						Won:    m.result.Winner != "" && game.Role(role).Faction() == m.result.Winner,
+						Text:   text,
+						Time:   time.Now(),
+					}
+				}
 				mu.Unlock()
 			}
-		}(name)
+		}(i, name)
 	}
 	wg.Wait()
+
+	var lessons []memory.Lesson
+	for _, lesson := range seatLessons {
+		if lesson != nil {
+			lessons = append(lessons, *lesson)
+		}
+	}
+
+	if m.memory != nil && m.recordLessons && len(lessons) > 0 {
+		if err := m.memory.Add(lessons...); err != nil {
+			fmt.Printf("⚠️ %v\n", err)
+		}
+	}
+}
+
+// extractLesson 从反思中提取经验：取最后一行以经验前缀开头的内容，没有时返回空字符串（不记录）
+func (m *ModeratorAgent) extractLesson(reflection string) string {
+	lines := strings.Split(reflection, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimLeft(strings.TrimSpace(lines[i]), "*#- ")
+		for _, prefix := range m.locale.I18n.LessonPrefixes {
+			if idx := strings.Index(line, prefix); idx >= 0 {
+				if text := strings.TrimSpace(strings.Trim(strings.TrimSpace(line[idx+len(prefix):]), "*")); text != "" {
+					return text
+				}
+			}
+		}
+	}
+	return ""
 }
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+func TestDayAnnouncesEachDeathOnce(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleWerewolf, game.RoleWitch, game.RoleVillager, game.RoleVillager, game.RoleVillager}
+
+	tests := []struct {
+		name     string
+		killed   string
+		saved    bool
+		poisoned string
+		want     string // 公布的死亡名单，为空表示平安夜
+	}{
+		{"狼刀和毒药是同一人", "Player4", false, "Player4", "Player4"},
+		{"自刀的狼人又被毒", "Player2", false, "Player2", "Player2"},
+		{"狼刀和毒药各一人", "Player4", false, "Player1", "Player4, Player1"},
+		{"被救后没有人死亡", "Player4", true, "", ""},
+		{"被救的人没有被毒", "Player4", true, "Player1", "Player1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModerator(t, params.DefaultBoard, roles, nil)
+			m.state.Round = 1
+			m.state.SetNightKilled(tt.killed)
+			m.state.SetNightSaved(tt.saved)
+			m.state.SetNightPoisoned(tt.poisoned)
+
+			drive(func(gen *adkGen) {
+				m.resolveNight(context.Background(), gen)
+				m.dayPhase(context.Background(), gen)
+			})
+
+			prefix, suffix, _ := strings.Cut(m.locale.I18n.ModDeaths, "%s")
+			var announced []string
+			for _, e := range m.logger.Events() {
+				if e.Type == game.EventModeratorMsg && strings.HasPrefix(e.Content, prefix) && strings.HasSuffix(e.Content, suffix) {
+					announced = append(announced, e.Content)
+				}
+			}
+			var want []string
+			if tt.want != "" {
+				want = []string{fmt.Sprintf(m.locale.I18n.ModDeaths, tt.want)}
+			}
+			if !sameMembers(announced, want) {
+				t.Errorf("公布的死亡 %q，期望 %q", announced, want)
+			}
+		})
+	}
+}
+
+func TestExtractLesson(t *testing.T) {
+	m := newTestModerator(t, params.DefaultBoard, []game.Role{game.RoleWerewolf, game.RoleVillager}, nil)
+
+	tests := []struct {
+		reflection string
+		want       string
+	}{
+		{"这局我跳得太早。\n经验：先听完再表态", "先听完再表态"},
+		{"经验：旧的\n**经验：** 新的", "新的"},
+		{"这局我跳得太早，下次要先听完再表态。", ""}, // 没有经验行时不记录
+		{"经验：", ""},
+	}
+	for _, tt := range tests {
+		if got := m.extractLesson(tt.reflection); got != tt.want {
+			t.Errorf("extractLesson(%q) = %q，期望 %q", tt.reflection, got, tt.want)
+		}
+	}
+}
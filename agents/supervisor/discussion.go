@@ -0,0 +1,213 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/adk"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+	"github.com/ashwinyue/wolf-go-adk/utils"
+)
+
+// speech 一次白天发言，Claim 为发言附带的结构化内容（玩家调用了 speech 工具时）
+type speech struct {
+	Player  string
+	Content string
+	Claim   *game.SpeechClaim
+}
+
+// speakingOrder 按策略计算本轮发言顺序
+func (m *ModeratorAgent) speakingOrder(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], order params.SpeakingOrder) []string {
+	switch order {
+	case params.OrderClockwise:
+		return m.state.AlivePlayersFrom(m.state.LastDead, true)
+	case params.OrderCounterClockwise:
+		return m.state.AlivePlayersFrom(m.state.LastDead, false)
+	case params.OrderSheriff:
+		return m.sheriffOrder(ctx, gen)
+	case params.OrderRandom:
+		alive := m.state.GetAlivePlayers()
+		m.rng.Shuffle(len(alive), func(i, j int) {
+			alive[i], alive[j] = alive[j], alive[i]
+		})
+		return alive
+	default:
+		return m.state.GetAlivePlayers()
+	}
+}
+
+// sheriffOrder 由警长通过 direction 工具决定发言方向，警长最后发言
+// 没有存活警长时退化为从最近死者开始顺时针；警长没有给出合法方向时按顺时针
+func (m *ModeratorAgent) sheriffOrder(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent]) []string {
+	sheriff := m.state.GetSheriff()
+	if sheriff == "" || !m.state.IsAlive(sheriff) {
+		m.sendMessage(gen, "  "+m.locale.I18n.NoSheriff)
+		return m.state.AlivePlayersFrom(m.state.LastDead, true)
+	}
+
+	args, _ := m.callPlayerWithTool(ctx, sheriff, fmt.Sprintf(m.locale.Prompts.ToSheriffOrder, sheriff), "direction")
+	answer, _ := args["direction"].(string)
+	clockwise := params.SpeakingOrder(strings.ToLower(strings.TrimSpace(answer))) != params.OrderCounterClockwise
+
+	direction := m.locale.I18n.Clockwise
+	if !clockwise {
+		direction = m.locale.I18n.CounterClockwise
+	}
+	m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.SheriffDirection, sheriff, direction))
+	m.logger.LogModerator(fmt.Sprintf(m.locale.I18n.ModSheriff, sheriff, direction))
+
+	// 从警长的下一位开始，警长归票
+	order := m.state.AlivePlayersFrom(sheriff, clockwise)
+	var result []string
+	for _, p := range order {
+		if p != sheriff {
+			result = append(result, p)
+		}
+	}
+	return append(result, sheriff)
+}
+
+// speakPrompt 轮到玩家发言时的提示，玩家有 speech 工具时提示可以先调用它
+func (m *ModeratorAgent) speakPrompt() string {
+	if m.speechTool {
+		return m.locale.Prompts.ToPlayerSpeak + m.locale.Prompts.ToSpeechTool
+	}
+	return m.locale.Prompts.ToPlayerSpeak
+}
+
+// sequentialSpeeches 按顺序依次发言，每人都能听到前面玩家的发言
+func (m *ModeratorAgent) sequentialSpeeches(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], order []string) []speech {
+	var speeches []speech
+	for _, player := range order {
+		response, streamed := m.speak(ctx, gen, player, m.speakPrompt())
+		if response != "" {
+			if !streamed {
+				m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.PlayerSpeaks, player, utils.Truncate(response, 200)))
+			}
+			// 广播给所有人
+			m.broadcastToAll(fmt.Sprintf("[%s]: %s", player, response))
+			m.logger.LogDiscussion(player, response)
+			claim := m.publishClaim(player)
+			speeches = append(speeches, speech{Player: player, Content: response, Claim: claim})
+		}
+	}
+	return speeches
+}
+
+// parallelSpeeches 所有玩家同时发言，本轮结束后再统一广播
+// 并行发言不做流式转发，避免多名玩家的输出交错在一起
+func (m *ModeratorAgent) parallelSpeeches(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], order []string) []speech {
+	responses := make([]string, len(order))
+	var wg sync.WaitGroup
+
+	for i, player := range order {
+		wg.Add(1)
+		go func(idx int, p string) {
+			defer wg.Done()
+			responses[idx] = m.callPlayer(ctx, p, m.speakPrompt())
+		}(i, player)
+	}
+	wg.Wait()
+
+	var speeches []speech
+	for i, player := range order {
+		response := responses[i]
+		if response == "" {
+			continue
+		}
+		m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.PlayerSpeaks, player, utils.Truncate(response, 200)))
+		m.broadcastToAll(fmt.Sprintf("[%s]: %s", player, response))
+		m.logger.LogDiscussion(player, response)
+		claim := m.publishClaim(player)
+		speeches = append(speeches, speech{Player: player, Content: response, Claim: claim})
+	}
+	return speeches
+}
+
+// rebuttalRound 反驳轮：被其他玩家点名的玩家可以针对指控进行回应
+func (m *ModeratorAgent) rebuttalRound(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], order []string, speeches []speech) {
+	accusations := extractAccusations(order, speeches)
+	if len(accusations) == 0 {
+		return
+	}
+
+	m.sendMessage(gen, "  "+m.locale.I18n.RebuttalPhase)
+	m.logger.LogPhase(m.locale.I18n.PhaseRebuttal)
+
+	for _, player := range order {
+		lines, ok := accusations[player]
+		if !ok {
+			continue
+		}
+
+		query := fmt.Sprintf(m.locale.Prompts.ToRebuttal, strings.Join(lines, "\n"))
+		response, streamed := m.speak(ctx, gen, player, query)
+		if response != "" {
+			if !streamed {
+				m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.Rebuttal, player, utils.Truncate(response, 200)))
+			}
+			m.broadcastToAll(fmt.Sprintf(m.locale.Prompts.ToAllRebuttal, player, response))
+			m.logger.LogDiscussion(player, response)
+			m.publishClaim(player)
+		}
+	}
+}
+
+// extractAccusations 找出每名玩家受到指控的发言
+func extractAccusations(players []string, speeches []speech) map[string][]string {
+	accusations := make(map[string][]string)
+	for _, s := range speeches {
+		for _, p := range s.accused() {
+			if p == s.Player || !slices.Contains(players, p) {
+				continue
+			}
+			accusations[p] = append(accusations[p], fmt.Sprintf("[%s]: %s", s.Player, utils.Truncate(s.Content, 200)))
+		}
+	}
+	return accusations
+}
+
+// accused 返回本次发言指控的玩家：附带结构化内容时取其中怀疑的玩家和声称查杀的玩家，
+// 否则取发言中点到的座位名（按完整座位名匹配，Player10 不会算作 Player1），每名玩家只计一次
+func (s speech) accused() []string {
+	var names []string
+	if s.Claim != nil {
+		names = append(names, s.Claim.Suspects...)
+		for _, c := range s.Claim.Checks {
+			if c.IsWolf {
+				names = append(names, c.Target)
+			}
+		}
+	} else {
+		names = seatPattern.FindAllString(s.Content, -1)
+	}
+
+	var result []string
+	for _, name := range names {
+		if !slices.Contains(result, name) {
+			result = append(result, name)
+		}
+	}
+	return result
+}
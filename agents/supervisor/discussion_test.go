@@ -0,0 +1,135 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+func TestSpeakingOrder(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleVillager, game.RoleVillager, game.RoleSeer, game.RoleWitch, game.RoleHunter}
+
+	tests := []struct {
+		name    string
+		order   params.SpeakingOrder
+		sheriff string
+		reply   string // 警长对发言方向的回复
+		calls   []schema.ToolCall
+		want    []string
+	}{
+		{"座位顺序", params.OrderSeat, "", "", nil, []string{"Player1", "Player2", "Player4", "Player5", "Player6"}},
+		{"从死者顺时针", params.OrderClockwise, "", "", nil, []string{"Player4", "Player5", "Player6", "Player1", "Player2"}},
+		{"从死者逆时针", params.OrderCounterClockwise, "", "", nil, []string{"Player2", "Player1", "Player6", "Player5", "Player4"}},
+		{"警长顺时针", params.OrderSheriff, "Player5", "好的", []schema.ToolCall{toolCall("direction", `{"direction":"clockwise"}`)}, []string{"Player6", "Player1", "Player2", "Player4", "Player5"}},
+		{"警长逆时针", params.OrderSheriff, "Player5", "好的", []schema.ToolCall{toolCall("direction", `{"direction":"counterclockwise"}`)}, []string{"Player4", "Player2", "Player1", "Player6", "Player5"}},
+		{"警长 JSON 回答逆时针", params.OrderSheriff, "Player5", `{"direction":"counterclockwise"}`, nil, []string{"Player4", "Player2", "Player1", "Player6", "Player5"}},
+		{"文字中的方向不算数", params.OrderSheriff, "Player5", "不要逆时针，按顺时针", nil, []string{"Player6", "Player1", "Player2", "Player4", "Player5"}},
+		{"没有警长", params.OrderSheriff, "", "", nil, []string{"Player4", "Player5", "Player6", "Player1", "Player2"}},
+		{"警长已出局", params.OrderSheriff, "Player3", "好的", []schema.ToolCall{toolCall("direction", `{"direction":"counterclockwise"}`)}, []string{"Player4", "Player5", "Player6", "Player1", "Player2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var replies map[string][]string
+			if tt.sheriff != "" {
+				replies = map[string][]string{tt.sheriff: {tt.reply}}
+			}
+			m := newTestModerator(t, params.DefaultBoard, roles, replies)
+			if tt.sheriff != "" {
+				m.playerAgents[tt.sheriff].(*scriptedAgent).toolCalls = [][]schema.ToolCall{tt.calls}
+			}
+			m.state.KillPlayer("Player3")
+			m.state.SetSheriff(tt.sheriff)
+
+			var got []string
+			drive(func(gen *adkGen) { got = m.speakingOrder(context.Background(), gen, tt.order) })
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("speakingOrder(%s) = %v，期望 %v", tt.order, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpeakingOrderRandomKeepsAlivePlayers(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleVillager, game.RoleVillager, game.RoleSeer}
+	m := newTestModerator(t, params.DefaultBoard, roles, nil)
+	m.state.KillPlayer("Player2")
+
+	var got []string
+	drive(func(gen *adkGen) { got = m.speakingOrder(context.Background(), gen, params.OrderRandom) })
+	if !sameMembers(got, []string{"Player1", "Player3", "Player4"}) {
+		t.Errorf("随机顺序 %v 应当恰好包含全部存活玩家", got)
+	}
+}
+
+func TestSpeakPromptMentionsSpeechToolOnlyWhenEnabled(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleVillager, game.RoleSeer}
+	for _, enabled := range []bool{false, true} {
+		m := newTestModerator(t, params.DefaultBoard, roles, map[string][]string{"Player2": {"我是好人"}})
+		m.speechTool = enabled
+
+		drive(func(gen *adkGen) { m.sequentialSpeeches(context.Background(), gen, []string{"Player2"}) })
+
+		prompts := m.playerAgents["Player2"].(*scriptedAgent).prompts
+		if len(prompts) != 1 {
+			t.Fatalf("Player2 收到 %d 次提示，期望 1 次", len(prompts))
+		}
+		if got := strings.Contains(prompts[0], "speech"); got != enabled {
+			t.Errorf("启用 speech 工具 = %v 时发言提示 %q 提到 speech = %v", enabled, prompts[0], got)
+		}
+	}
+}
+
+func TestExtractAccusations(t *testing.T) {
+	players := []string{"Player1", "Player2", "Player3", "Player10"}
+	tests := []struct {
+		name     string
+		speeches []speech
+		want     map[string]int // 每名玩家受到指控的发言数
+	}{
+		{"按完整座位名匹配", []speech{{Player: "Player2", Content: "我怀疑 Player10"}}, map[string]int{"Player10": 1}},
+		{"同一发言多次点名只算一次", []speech{{Player: "Player2", Content: "Player1，Player1，还是 Player1"}}, map[string]int{"Player1": 1}},
+		{"不算自己", []speech{{Player: "Player2", Content: "我是 Player2，Player3 可疑"}}, map[string]int{"Player3": 1}},
+		{"忽略不在发言顺序中的座位", []speech{{Player: "Player2", Content: "Player9 可疑"}}, map[string]int{}},
+		{"结构化发言只取怀疑和查杀", []speech{{
+			Player:  "Player2",
+			Content: "我信任 Player1，Player3 和 Player10 都很可疑",
+			Claim:   &game.SpeechClaim{Suspects: []string{"Player3"}, Trusted: []string{"Player1"}, Checks: []game.ClaimedCheck{{Target: "Player10", IsWolf: true}, {Target: "Player1"}}},
+		}}, map[string]int{"Player3": 1, "Player10": 1}},
+		{"结构化发言没有怀疑对象", []speech{{Player: "Player2", Content: "Player3 发言很好", Claim: &game.SpeechClaim{Trusted: []string{"Player3"}}}}, map[string]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractAccusations(players, tt.speeches)
+			counts := make(map[string]int)
+			for p, lines := range got {
+				counts[p] = len(lines)
+			}
+			if !maps.Equal(counts, tt.want) {
+				t.Errorf("extractAccusations() = %v，期望 %v", counts, tt.want)
+			}
+		})
+	}
+}
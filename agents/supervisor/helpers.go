@@ -19,8 +19,16 @@ package supervisor
 import (
 	"github.com/cloudwego/eino/adk"
 	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/telemetry"
 )
 
+// recordFallback 记录一次回退到文本解析
+func (m *ModeratorAgent) recordFallback() {
+	m.fallbacks.Add(1)
+	telemetry.RecordFallback()
+}
+
 // sendMessage 发送消息事件
 func (m *ModeratorAgent) sendMessage(gen *adk.AsyncGenerator[*adk.AgentEvent], content string) {
 	gen.Send(&adk.AgentEvent{
@@ -38,11 +46,11 @@ func (m *ModeratorAgent) sendMessage(gen *adk.AsyncGenerator[*adk.AgentEvent], c
 	})
 }
 
-// broadcastToAll 广播消息给所有玩家
+// broadcastToAll 广播消息给所有接收公开消息的玩家，出局玩家按板子的 dead_seats 策略处理
 func (m *ModeratorAgent) broadcastToAll(content string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	for name := range m.playerMsgs {
-		m.playerMsgs[name] = append(m.playerMsgs[name], &schema.Message{Role: schema.User, Content: content})
+		m.deliver(name, content)
 	}
 }
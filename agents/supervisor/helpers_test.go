@@ -0,0 +1,131 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// scriptedAgent 按顺序返回预设回复的玩家 Agent，回复用完后重复最后一条；prompts 记录每次收到的最后一条消息
+// toolCalls[i] 不为空时，第 i 次回复之前先输出一条发起这些工具调用的助手消息
+type scriptedAgent struct {
+	mu        sync.Mutex
+	replies   []string
+	toolCalls [][]schema.ToolCall
+	calls     int
+	prompts   []string
+}
+
+func (a *scriptedAgent) Name(ctx context.Context) string        { return "scripted" }
+func (a *scriptedAgent) Description(ctx context.Context) string { return "scripted player" }
+
+func (a *scriptedAgent) Run(ctx context.Context, input *adk.AgentInput, options ...adk.AgentRunOption) *adk.AsyncIterator[*adk.AgentEvent] {
+	a.mu.Lock()
+	reply := a.replies[len(a.replies)-1]
+	if a.calls < len(a.replies) {
+		reply = a.replies[a.calls]
+	}
+	var toolCalls []schema.ToolCall
+	if a.calls < len(a.toolCalls) {
+		toolCalls = a.toolCalls[a.calls]
+	}
+	a.calls++
+	if n := len(input.Messages); n > 0 {
+		a.prompts = append(a.prompts, input.Messages[n-1].Content)
+	}
+	a.mu.Unlock()
+
+	iter, gen := adk.NewAsyncIteratorPair[*adk.AgentEvent]()
+	if len(toolCalls) > 0 {
+		gen.Send(adk.EventFromMessage(schema.AssistantMessage("", toolCalls), nil, schema.Assistant, ""))
+	}
+	gen.Send(adk.EventFromMessage(schema.AssistantMessage(reply, nil), nil, schema.Assistant, ""))
+	gen.Close()
+	return iter
+}
+
+// testSeats 测试用的座位，角色与 roles 一一对应
+func testSeats(n int) []string {
+	names := []string{"Player1", "Player2", "Player3", "Player4", "Player5", "Player6", "Player7", "Player8", "Player9"}
+	return names[:n]
+}
+
+// newTestModerator 用预设回复的玩家创建主持人，replies 中没有的座位总是回复空 JSON
+func newTestModerator(t *testing.T, board params.BoardConfig, roles []game.Role, replies map[string][]string) *ModeratorAgent {
+	t.Helper()
+	locale := params.NewLocale("zh")
+	seats := testSeats(len(roles))
+
+	state := game.NewGameState()
+	state.InitPlayers(seats, roles)
+	state.SetRules(game.Rules{EmptyKill: board.EmptyKill, SelfKnife: board.SelfKnife, SeerCheck: board.CheckMode()})
+
+	// 与 NewModeratorAgent 一样为每个座位准备系统提示，公开消息按座位投递
+	agents := make(map[string]adk.Agent)
+	playerMsgs := make(map[string][]*schema.Message)
+	for _, seat := range seats {
+		r := replies[seat]
+		if len(r) == 0 {
+			r = []string{`{}`}
+		}
+		agents[seat] = &scriptedAgent{replies: r}
+		playerMsgs[seat] = []*schema.Message{schema.SystemMessage(locale.BuildPlayerInstruction(seat, state.GetPlayerRole(seat)))}
+	}
+
+	return &ModeratorAgent{
+		state:        state,
+		logger:       game.NewGameLogger(t.TempDir(), &locale.I18n.Log),
+		board:        board,
+		locale:       locale,
+		rng:          rand.New(rand.NewSource(1)),
+		playerAgents: agents,
+		playerMsgs:   playerMsgs,
+		transcripts:  make(map[string][]game.TranscriptMessage),
+		missed:       make(map[string][]string),
+	}
+}
+
+// toolCall 构造一次工具调用
+func toolCall(name, args string) schema.ToolCall {
+	return schema.ToolCall{ID: name, Type: "function", Function: schema.FunctionCall{Name: name, Arguments: args}}
+}
+
+// adkGen 主持人写入事件的生成器
+type adkGen = adk.AsyncGenerator[*adk.AgentEvent]
+
+// drive 在主持人的事件流上运行 fn 并丢弃输出
+func drive(fn func(gen *adkGen)) {
+	iter, gen := adk.NewAsyncIteratorPair[*adk.AgentEvent]()
+	go func() {
+		defer gen.Close()
+		fn(gen)
+	}()
+	for {
+		if _, ok := iter.Next(); !ok {
+			return
+		}
+	}
+}
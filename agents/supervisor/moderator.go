@@ -22,28 +22,94 @@ import (
 	"math/rand"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cloudwego/eino/adk"
 	"github.com/cloudwego/eino/schema"
 
+	"github.com/ashwinyue/wolf-go-adk/agents/commentator"
 	"github.com/ashwinyue/wolf-go-adk/agents/players"
+	"github.com/ashwinyue/wolf-go-adk/analysis"
 	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/memory"
 	"github.com/ashwinyue/wolf-go-adk/params"
+	"github.com/ashwinyue/wolf-go-adk/telemetry"
+	"github.com/ashwinyue/wolf-go-adk/utils"
 )
 
 // ModeratorAgent 主持人 Agent（自定义实现 adk.Agent 接口）
 type ModeratorAgent struct {
 	state        *game.GameState
 	logger       *game.GameLogger
+	board        params.BoardConfig
+	locale       *params.Locale
+	seed         int64
+	model        string // 本局使用的模型名称，用于经验库和结果统计
+	trackBeliefs bool
+	speechTool   bool       // 玩家有 speech 工具，发言提示中提到它
+	streaming    bool       // 调用方开启了流式输出，公开发言逐段转发
+	rng          *rand.Rand // 本局随机源，用于角色分配、随机发言顺序和平票抽签；相同种子得到相同的角色分配
 	playerAgents map[string]adk.Agent
-	playerMsgs   map[string][]*schema.Message // 玩家消息历史
+	playerMsgs   map[string][]*schema.Message        // 玩家消息历史
+	transcripts  map[string][]game.TranscriptMessage // 玩家看到的完整对话（含工具调用和结果），导出到 seats/
+	missed       map[string][]string                 // spectate 策略下出局玩家错过的公开消息
+	nightDead    []string                            // 上一夜死亡的玩家（已去重），天亮时公布
 	mu           sync.RWMutex
+
+	commentator    adk.Agent         // 解说员，为空时不解说
+	commentaryMsgs []*schema.Message // 解说员自己的消息历史，与玩家历史完全隔离
+	commentarySeq  int               // 已经交给解说员的最后一个事件序号
+
+	memory        *memory.Store // 跨局经验库，为空时不读写
+	recordLessons bool          // 是否把赛后反思中的经验写入经验库
+
+	result    game.GameResult // 本局结果统计
+	fallbacks atomic.Int64    // 回退到文本解析的次数
+}
+
+// GameConfig 单局游戏配置
+// 语言、板子和日志目录都属于单局游戏，多局可以在同一进程中并行运行
+type GameConfig struct {
+	Board  params.BoardConfig
+	Locale *params.Locale // 为空时使用中文
+	LogDir string         // 日志根目录，为空时使用 logs
+	Seed   int64          // 随机种子，为 0 时使用当前时间
+
+	// 玩家和解说员使用的模型，各项为空时读取 MODEL_TYPE 和提供方的环境变量
+	Model utils.ModelConfig
+
+	// 每天投票前要求玩家通过 belief 工具提交对其他玩家是狼人的概率判断
+	TrackBeliefs bool
+
+	// 为玩家附加 speech 工具，白天发言时可以提交身份声明、查验结果、怀疑和信任的玩家
+	StructuredSpeech bool
+
+	// 每个阶段结束后由解说员面向观众点评局势，写入 commentary.md
+	Commentary bool
+
+	// 跨局经验库：RecordLessons 时把赛后反思中的经验写入经验库，
+	// InjectLessons 大于 0 时为每个角色注入经验库中的前 N 条经验
+	Memory        *memory.Store
+	RecordLessons bool
+	InjectLessons int
 }
 
 // NewModeratorAgent 创建主持人 Agent
-func NewModeratorAgent(ctx context.Context) (*ModeratorAgent, error) {
+func NewModeratorAgent(ctx context.Context, cfg GameConfig) (*ModeratorAgent, error) {
+	locale := cfg.Locale
+	if locale == nil {
+		locale = params.NewLocale("")
+	}
+
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(seed))
+
 	state := game.NewGameState()
-	logger := game.NewGameLogger()
+	logger := game.NewGameLogger(cfg.LogDir, &locale.I18n.Log)
 
 	// 初始化玩家名单
 	playerNames := []string{
@@ -52,19 +118,16 @@ func NewModeratorAgent(ctx context.Context) (*ModeratorAgent, error) {
 		"Player7", "Player8", "Player9",
 	}
 
-	// 角色分配：3狼人 + 3村民 + 1预言家 + 1女巫 + 1猎人
-	roles := []game.Role{
-		game.RoleWerewolf, game.RoleWerewolf, game.RoleWerewolf,
-		game.RoleVillager, game.RoleVillager, game.RoleVillager,
-		game.RoleSeer, game.RoleWitch, game.RoleHunter,
-	}
+	// 角色分配：3狼人 + 3村民 + 1预言家 + 1女巫 + 1猎人，板子要求时用隐狼替换部分狼人
+	roles := cfg.Board.Roles()
 
 	// 洗牌
-	rand.Shuffle(len(roles), func(i, j int) {
+	rng.Shuffle(len(roles), func(i, j int) {
 		roles[i], roles[j] = roles[j], roles[i]
 	})
 
 	state.InitPlayers(playerNames, roles)
+	state.SetRules(game.Rules{EmptyKill: cfg.Board.EmptyKill, SelfKnife: cfg.Board.SelfKnife, SeerCheck: cfg.Board.CheckMode()})
 
 	// 记录角色分配
 	playerRoles := make(map[string]game.Role)
@@ -73,25 +136,71 @@ func NewModeratorAgent(ctx context.Context) (*ModeratorAgent, error) {
 	}
 	logger.SetPlayers(playerRoles)
 
+	// 注入往届经验
+	if cfg.Memory != nil && cfg.InjectLessons > 0 {
+		lessons := make(map[game.Role][]string)
+		for _, role := range roles {
+			if _, ok := lessons[role]; ok {
+				continue
+			}
+			top, err := cfg.Memory.Top(cfg.Model.Name(), role, cfg.InjectLessons)
+			if err != nil {
+				return nil, fmt.Errorf("读取经验库失败: %w", err)
+			}
+			lessons[role] = top
+		}
+		locale = locale.WithLessons(lessons)
+	}
+
+	// 系统提示中的角色构成按本局实际发出的角色生成
+	locale = locale.WithRoles(roles)
+
 	// 创建玩家 Agent
-	playerAgents, err := players.CreatePlayerAgents(ctx, state)
+	playerAgents, err := players.CreatePlayerAgents(ctx, state, locale, players.Options{
+		Model:            cfg.Model,
+		StructuredSpeech: cfg.StructuredSpeech,
+		TrackBeliefs:     cfg.TrackBeliefs,
+		SheriffOrder:     cfg.Board.Discussion.Order == params.OrderSheriff,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("创建玩家 Agent 失败: %w", err)
 	}
 
 	// 初始化玩家消息历史
 	playerMsgs := make(map[string][]*schema.Message)
+	transcripts := make(map[string][]game.TranscriptMessage)
 	for name, player := range state.Players {
-		playerMsgs[name] = []*schema.Message{
-			{Role: schema.System, Content: params.BuildPlayerInstruction(name, game.Role(player.Role))},
+		system := &schema.Message{Role: schema.System, Content: locale.BuildPlayerInstruction(name, player.Role)}
+		playerMsgs[name] = []*schema.Message{system}
+		transcripts[name] = []game.TranscriptMessage{transcriptMessage(system)}
+	}
+
+	var commentatorAgent adk.Agent
+	if cfg.Commentary {
+		commentatorAgent, err = commentator.NewCommentatorAgent(ctx, locale, cfg.Model)
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return &ModeratorAgent{
 		state:        state,
 		logger:       logger,
+		board:        cfg.Board,
+		locale:       locale,
+		seed:         seed,
+		model:        cfg.Model.Name(),
+		trackBeliefs: cfg.TrackBeliefs,
+		speechTool:   cfg.StructuredSpeech,
+		rng:          rng,
 		playerAgents: playerAgents,
 		playerMsgs:   playerMsgs,
+		transcripts:  transcripts,
+		missed:       make(map[string][]string),
+		commentator:  commentatorAgent,
+
+		memory:        cfg.Memory,
+		recordLessons: cfg.RecordLessons,
 	}, nil
 }
 
@@ -108,15 +217,24 @@ func (m *ModeratorAgent) Description(ctx context.Context) string {
 // Run 运行游戏
 func (m *ModeratorAgent) Run(ctx context.Context, input *adk.AgentInput, options ...adk.AgentRunOption) *adk.AsyncIterator[*adk.AgentEvent] {
 	iter, gen := adk.NewAsyncIteratorPair[*adk.AgentEvent]()
+	m.streaming = input != nil && input.EnableStreaming
 
 	go func() {
+		ctx, span := telemetry.StartSpan(ctx, "game",
+			telemetry.AttrGameID.String(m.logger.GameID()),
+			telemetry.AttrSeed.Int64(m.seed),
+		)
+
 		// panic 恢复（ADK 最佳实践）
 		defer func() {
+			var err error
 			if e := recover(); e != nil {
-				gen.Send(&adk.AgentEvent{
-					Err: fmt.Errorf("recover from panic: %v", e),
-				})
+				err = fmt.Errorf("recover from panic: %v", e)
+				gen.Send(&adk.AgentEvent{Err: err})
 			}
+			span.SetAttributes(telemetry.AttrWinner.String(string(m.result.Winner)))
+			telemetry.EndSpan(span, err)
+			telemetry.RecordGame(string(m.result.Winner))
 			gen.Close()
 		}()
 
@@ -124,56 +242,118 @@ func (m *ModeratorAgent) Run(ctx context.Context, input *adk.AgentInput, options
 		m.announceGameStart(gen)
 
 		// 游戏主循环
-		for round := 1; round <= params.MaxGameRound; round++ {
-			m.state.Round = round
-			m.sendMessage(gen, fmt.Sprintf("\n========== 第 %d 回合 ==========", round))
-			m.logger.LogRound(round)
-
-			// 夜晚阶段
-			m.nightPhase(ctx, gen)
-
-			// 检查胜利条件
-			if winner := m.state.CheckWinner(); winner != "" {
-				m.announceWinner(gen, winner)
-				m.playerReflection(ctx, gen)
-				_ = m.logger.Save()
-				return
-			}
-
-			// 白天阶段
-			m.dayPhase(ctx, gen)
-
-			// 检查胜利条件
-			if winner := m.state.CheckWinner(); winner != "" {
-				m.announceWinner(gen, winner)
-				m.playerReflection(ctx, gen)
-				_ = m.logger.Save()
+		for round := 1; round <= m.board.GameRounds(); round++ {
+			if m.playRound(ctx, gen, round) {
 				return
 			}
-
-			m.state.FirstDay = false
+			// 每回合结束时导出一次座位对话记录，游戏中断时也能看到之前的内容
+			m.saveTranscripts()
 		}
 
-		m.sendMessage(gen, "\n⚠️ 游戏超过最大回合数，强制结束")
-		_ = m.logger.Save()
+		m.sendMessage(gen, "\n"+m.locale.I18n.GameEnded)
+		m.saveLogs()
 	}()
 
 	return iter
 }
 
+// playRound 进行一个回合（夜晚 + 白天），游戏在本回合结束时返回 true
+func (m *ModeratorAgent) playRound(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], round int) bool {
+	ctx, span := telemetry.StartSpan(ctx, "round", telemetry.AttrRound.Int(round))
+	defer span.End()
+
+	m.state.Round = round
+	m.sendMessage(gen, "\n"+fmt.Sprintf(m.locale.I18n.Round, round))
+	m.logger.LogRound(round)
+
+	// 夜晚阶段
+	m.runPhase(ctx, "night", func(ctx context.Context) {
+		m.nightPhase(ctx, gen)
+		m.commentate(ctx, gen, m.locale.I18n.PhaseNight)
+	})
+
+	// 检查胜利条件
+	if winner := m.state.CheckWinner(); winner != "" {
+		m.endGame(ctx, gen, winner)
+		return true
+	}
+
+	// 白天阶段
+	m.runPhase(ctx, "day", func(ctx context.Context) {
+		m.dayPhase(ctx, gen)
+		m.commentate(ctx, gen, m.locale.I18n.PhaseDay)
+	})
+
+	// 检查胜利条件
+	if winner := m.state.CheckWinner(); winner != "" {
+		m.endGame(ctx, gen, winner)
+		return true
+	}
+
+	m.state.FirstDay = false
+	return false
+}
+
+// runPhase 在阶段 span 中运行一个阶段
+func (m *ModeratorAgent) runPhase(ctx context.Context, phase string, fn func(ctx context.Context)) {
+	ctx, span := telemetry.StartSpan(ctx, "phase."+phase, telemetry.AttrPhase.String(phase))
+	defer span.End()
+	fn(ctx)
+}
+
+// endGame 宣布胜利、收集反思并保存日志
+func (m *ModeratorAgent) endGame(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], winner game.Faction) {
+	m.announceWinner(gen, winner)
+	m.runPhase(ctx, "reflection", func(ctx context.Context) {
+		m.playerReflection(ctx, gen)
+	})
+	m.saveLogs()
+}
+
+// Result 返回本局游戏结果，应在 Run 返回的事件流结束后调用
+func (m *ModeratorAgent) Result() game.GameResult {
+	result := m.result
+	result.GameID = m.logger.GameID()
+	result.Seed = m.seed
+	result.Roles = make(map[string]game.Role)
+	for name, player := range m.state.Players {
+		result.Roles[name] = player.Role
+	}
+	result.Rounds = m.state.Round
+	result.SeerSurvived = m.state.Seer != "" && m.state.IsAlive(m.state.Seer)
+	result.Fallbacks = int(m.fallbacks.Load())
+	result.Model = m.model
+	if belief, _ := game.ScoreBeliefs(m.state.Beliefs(), result.Roles); belief.Samples > 0 {
+		result.Belief = belief
+	}
+	return result
+}
+
+// saveLogs 保存游戏日志，并在日志目录中生成赛后分析
+func (m *ModeratorAgent) saveLogs() {
+	_ = m.logger.Save()
+	m.saveTranscripts()
+
+	report := analysis.Analyze(m.logger.Events(), m.locale)
+	report.GameID = m.logger.GameID()
+	if err := report.Save(m.logger.LogDir()); err != nil {
+		fmt.Printf("⚠️ %v\n", err)
+	}
+}
+
 // announceGameStart 宣布游戏开始
 func (m *ModeratorAgent) announceGameStart(gen *adk.AsyncGenerator[*adk.AgentEvent]) {
 	playerNames := m.state.GetAlivePlayers()
 
-	m.sendMessage(gen, "\n=== 🐺 狼人杀游戏开始 🐺 ===")
-	m.sendMessage(gen, fmt.Sprintf("玩家: %s", strings.Join(playerNames, ", ")))
+	m.sendMessage(gen, "\n"+m.locale.I18n.GameStarted)
+	m.sendMessage(gen, fmt.Sprintf("%s: %s", m.locale.I18n.Players, strings.Join(playerNames, ", ")))
 
 	// 广播游戏开始（与原版 to_all_new_game 一致）
-	m.broadcastToAll(fmt.Sprintf(params.Prompts.ToAllNewGame, strings.Join(playerNames, ", ")))
+	m.broadcastToAll(fmt.Sprintf(m.locale.Prompts.ToAllNewGame, strings.Join(playerNames, ", ")))
 
-	m.sendMessage(gen, "\n=== 角色分配 ===")
-	for name, player := range m.state.Players {
-		m.sendMessage(gen, fmt.Sprintf("  %s: %s", name, getRoleName(player.Role)))
+	m.sendMessage(gen, "\n"+m.locale.I18n.RoleAssignment)
+	for _, name := range m.state.Seats {
+		m.sendMessage(gen, fmt.Sprintf("  %s: %s", name, m.roleName(m.state.GetPlayerRole(name))))
 	}
 	m.sendMessage(gen, "=======================")
 }
@@ -187,43 +367,34 @@ func (m *ModeratorAgent) announceWinner(gen *adk.AsyncGenerator[*adk.AgentEvent]
 	m.sendMessage(gen, "\n========================================")
 	if winner == game.FactionWerewolf {
 		// 广播狼人胜利消息
-		msg := fmt.Sprintf(params.Prompts.ToAllWolfWin, aliveCount, aliveWolves, rolesStr)
+		msg := fmt.Sprintf(m.locale.Prompts.ToAllWolfWin, aliveCount, aliveWolves, rolesStr)
 		m.broadcastToAll(msg)
-		m.sendMessage(gen, "🐺 狼人阵营获胜！")
+		m.sendMessage(gen, m.locale.I18n.WerewolvesWin)
 	} else {
 		// 广播村民胜利消息
-		msg := fmt.Sprintf(params.Prompts.ToAllVillageWin, rolesStr)
+		msg := fmt.Sprintf(m.locale.Prompts.ToAllVillageWin, rolesStr)
 		m.broadcastToAll(msg)
-		m.sendMessage(gen, "👨‍🌾 好人阵营获胜！")
+		m.sendMessage(gen, m.locale.I18n.VillagersWin)
 	}
 
-	m.sendMessage(gen, "\n=== 最终角色揭示 ===")
-	for name, player := range m.state.Players {
-		status := "存活"
-		if !player.Alive {
-			status = "死亡"
+	m.sendMessage(gen, "\n"+m.locale.I18n.FinalRoles)
+	for _, name := range m.state.Seats {
+		status := m.locale.I18n.StatusAlive
+		if !m.state.IsAlive(name) {
+			status = m.locale.I18n.StatusDead
 		}
-		m.sendMessage(gen, fmt.Sprintf("  %s: %s (%s)", name, getRoleName(player.Role), status))
+		m.sendMessage(gen, fmt.Sprintf("  %s: %s (%s)", name, m.roleName(m.state.GetPlayerRole(name)), status))
 	}
 	m.sendMessage(gen, "========================================")
 
+	m.result.Winner = winner
 	m.logger.LogWinner(winner, m.state.GetAlivePlayers())
 }
 
-// getRoleName 获取角色中文名
-func getRoleName(role game.Role) string {
-	switch role {
-	case game.RoleWerewolf:
-		return "狼人"
-	case game.RoleVillager:
-		return "村民"
-	case game.RoleSeer:
-		return "预言家"
-	case game.RoleWitch:
-		return "女巫"
-	case game.RoleHunter:
-		return "猎人"
-	default:
-		return string(role)
+// roleName 获取角色在当前语言下的名称
+func (m *ModeratorAgent) roleName(role game.Role) string {
+	if name, ok := m.locale.I18n.RoleNames[role]; ok {
+		return name
 	}
+	return string(role)
 }
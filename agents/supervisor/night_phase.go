@@ -20,47 +20,50 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
-	"sync"
+	"time"
 
 	"github.com/cloudwego/eino/adk"
 	"github.com/cloudwego/eino/schema"
 
 	"github.com/ashwinyue/wolf-go-adk/game"
-	"github.com/ashwinyue/wolf-go-adk/params"
-	"github.com/ashwinyue/wolf-go-adk/tools"
+	"github.com/ashwinyue/wolf-go-adk/telemetry"
 	"github.com/ashwinyue/wolf-go-adk/utils"
 )
 
 // nightPhase 夜晚阶段
 func (m *ModeratorAgent) nightPhase(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent]) {
-	m.sendMessage(gen, "\n--- 🌙 夜晚阶段 ---")
+	m.sendMessage(gen, "\n"+m.locale.I18n.NightPhase)
 	m.state.ResetNightState()
-	m.state.Phase = "night"
-	m.logger.LogPhase("🌙 夜间阶段")
+	m.state.SetPhase("night")
+	m.logger.LogPhase(m.locale.I18n.PhaseNight)
 
 	// 广播夜间开始
-	m.broadcastToAll(params.Prompts.ToAllNight)
-	m.logger.LogModerator("天黑了，请所有人闭眼。")
+	m.broadcastToAll(m.locale.Prompts.ToAllNight)
+	m.logger.LogModerator(m.locale.I18n.ModNightFall)
 
 	// 1. 狼人行动
-	m.logger.LogModerator("狼人请睁眼，请选择今晚要击杀的玩家。")
+	m.logger.LogModerator(m.locale.I18n.ModWolvesWake)
 	m.werewolfAction(ctx, gen)
 
 	// 2. 女巫行动
-	m.logger.LogModerator("女巫请睁眼。")
+	m.logger.LogModerator(m.locale.I18n.ModWitchWake)
 	m.witchAction(ctx, gen)
 
 	// 3. 预言家行动
-	m.logger.LogModerator("预言家请睁眼，请选择要查验的玩家。")
+	m.logger.LogModerator(m.locale.I18n.ModSeerWake)
 	m.seerAction(ctx, gen)
 
 	// 4. 结算夜晚
-	m.logger.LogModerator("天亮了，请所有人睁眼。")
+	m.logger.LogModerator(m.locale.I18n.ModDawn)
 	m.resolveNight(ctx, gen)
 }
 
 // werewolfAction 狼人行动
+// 狼人按座位顺序轮流发言并提议击杀目标，所有存活狼人的当前提议相同即达成一致；
+// 讨论轮数用完仍未达成一致时按板子的 wolf_decision 规则决定
 func (m *ModeratorAgent) werewolfAction(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent]) {
 	wolves := m.state.GetAliveWerewolves()
 	if len(wolves) == 0 {
@@ -68,115 +71,94 @@ func (m *ModeratorAgent) werewolfAction(ctx context.Context, gen *adk.AsyncGener
 	}
 
 	alivePlayers := m.state.GetAlivePlayers()
-	nWolves := len(wolves)
 
 	// 广播讨论开始
-	discussionPrompt := fmt.Sprintf(params.Prompts.ToWolvesDiscussion,
+	discussionPrompt := fmt.Sprintf(m.locale.Prompts.ToWolvesDiscussion,
 		strings.Join(wolves, ", "), strings.Join(alivePlayers, ", "))
+	if m.board.EmptyKill {
+		discussionPrompt += m.locale.Prompts.ToWolvesEmptyKill
+	}
+	if m.board.SelfKnife {
+		discussionPrompt += m.locale.Prompts.ToWolvesSelfKnife
+	}
 	m.broadcastToWerewolves(discussionPrompt)
 
-	m.sendMessage(gen, fmt.Sprintf("  狼人 (%s) 正在讨论...", strings.Join(wolves, ", ")))
+	m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.WerewolvesDiscussing, strings.Join(wolves, ", ")))
 	m.logger.LogWerewolfDiscussionStart(wolves)
 
-	// 创建讨论工具
-	discussTool := tools.NewDiscussTool()
-
-	// 狼人多轮讨论（MaxDiscussionRound * 狼人数量）
-	reachAgreement := false
-	for round := 1; round <= params.MaxDiscussionRound*nWolves; round++ {
-		wolfIdx := (round - 1) % nWolves
-		wolf := wolves[wolfIdx]
-
-		// 构建带历史的提示
-		history := m.formatWerewolfHistory(wolf)
-		// 使用标准提示词，引导狼人进行真正的讨论和思考
-		basePrompt := fmt.Sprintf(params.Prompts.ToWolvesDiscussion,
-			strings.Join(wolves, ", "), strings.Join(alivePlayers, ", "))
-		promptText := basePrompt + history
-
-		// 使用结构化工具调用
-		if discussTool != nil {
-			result, err := m.callPlayerWithTool(ctx, wolf, promptText, discussTool)
-			if err == nil {
-				message := ""
-				if msg, ok := result["message"].(string); ok {
-					message = msg
+	// 每名狼人的当前提议
+	proposals := make(map[string]string)
+	agreed := ""
+negotiation:
+	for round := 1; round <= m.board.WolfRounds(); round++ {
+		for _, wolf := range wolves {
+			// 带上当前提议和讨论历史
+			promptText := discussionPrompt + m.formatProposals(wolves, proposals) + m.formatWerewolfHistory(wolf)
+
+			var message string
+			proposal, _ := m.decideTarget(ctx, gen, wolf, game.ActionWolfVote, promptText, func(result map[string]interface{}) (string, bool) {
+				message, _ = result["message"].(string)
+				target, _ := result["target"].(string)
+				// 文本回退猜出的空刀只在没有解析出目标时生效，明确给出的目标优先
+				if noKill, _ := result["no_kill"].(bool); noKill && target == "" && m.board.EmptyKill {
+					target = game.NoKill
 				}
-				m.sendMessage(gen, fmt.Sprintf("  [%s] (狼人第%d轮): %s", wolf, round, utils.Truncate(message, 200)))
+				return target, target != ""
+			})
+
+			if proposal != "" {
+				proposals[wolf] = proposal
+				m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.WerewolfProposal, wolf, round, proposal, utils.Truncate(message, 200)))
+				m.broadcastToWerewolves(fmt.Sprintf("[%s → %s]: %s", wolf, proposal, message))
+				m.logger.LogWerewolfDiscussion(wolf, round, proposal, message)
+			} else if message != "" {
+				m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.WerewolfRound, wolf, round, utils.Truncate(message, 200)))
 				m.broadcastToWerewolves(fmt.Sprintf("[%s]: %s", wolf, message))
-				m.logger.LogWerewolfDiscussion(wolf, round, message)
-
-				// 检查是否达成一致
-				if round%nWolves == 0 {
-					if agree, ok := result["reach_agreement"].(bool); ok && agree {
-						reachAgreement = true
-						m.sendMessage(gen, "  ✅ 狼人达成一致！")
-						break
-					}
-				}
-				continue
+				m.logger.LogWerewolfDiscussion(wolf, round, "", message)
 			}
-		}
 
-		// 回退到普通调用
-		response := m.callPlayer(ctx, wolf, promptText)
-		if response != "" {
-			m.sendMessage(gen, fmt.Sprintf("  [%s] (狼人第%d轮): %s", wolf, round, utils.Truncate(response, 200)))
-			m.broadcastToWerewolves(fmt.Sprintf("[%s]: %s", wolf, response))
-			m.logger.LogWerewolfDiscussion(wolf, round, response)
+			if agreed = unanimousProposal(wolves, proposals); agreed != "" {
+				m.sendMessage(gen, "  "+m.locale.I18n.WerewolvesAgreed)
+				break negotiation
+			}
 		}
 	}
 
-	if !reachAgreement {
-		m.sendMessage(gen, "  ⚠️ 狼人未达成一致，将进行投票")
+	killed, decision := agreed, m.locale.Prompts.ToWolvesAgreed
+	if agreed == "" {
+		m.sendMessage(gen, "  "+m.locale.I18n.WerewolvesNoAgreement)
+		killed, decision = m.resolveProposals(wolves, proposals)
 	}
 
-	// 狼人投票（并行）
-	m.broadcastToWerewolves(params.Prompts.ToWolvesVote)
-	m.sendMessage(gen, "  狼人投票中...")
-	m.logger.LogPhase("🗳️ 狼人投票")
-
-	votes := make(map[string]string)
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-
-	// 创建投票工具
-	voteTool := tools.NewVoteTool(m.state)
-
+	// 每名狼人的最终提议
 	for _, wolf := range wolves {
-		wg.Add(1)
-		go func(w string) {
-			defer wg.Done()
-
-			var target string
-			if voteTool != nil {
-				result, err := m.callPlayerWithTool(ctx, w, params.Prompts.ToWolvesVote, voteTool)
-				if err == nil {
-					if t, ok := result["target"].(string); ok {
-						target = t
-					}
-				}
-			}
+		if target, ok := proposals[wolf]; ok {
+			m.logger.LogWerewolfIndividualVote(wolf, target)
+		}
+	}
 
-			if target != "" {
-				mu.Lock()
-				votes[w] = target
-				mu.Unlock()
-				m.sendMessage(gen, fmt.Sprintf("  [%s] 投票: %s", w, target))
-				m.logger.LogWerewolfIndividualVote(w, target)
-			}
-		}(wolf)
+	if killed == "" {
+		m.sendMessage(gen, "  "+m.locale.I18n.WerewolvesNoTarget)
+		m.logger.LogModerator(m.locale.I18n.WerewolvesNoTarget)
+		return
 	}
-	wg.Wait()
 
-	// 统计投票结果
-	if len(votes) > 0 {
-		killed, details := utils.MajorityVote(votes)
-		m.state.SetNightKilled(killed)
-		m.broadcastToWerewolves(fmt.Sprintf(params.Prompts.ToWolvesRes, details, killed))
-		m.sendMessage(gen, fmt.Sprintf("  ➡️ 狼人决定杀: %s (%s)", killed, details))
-		m.logger.LogWerewolfVote(killed, details)
+	details := proposalList(wolves, proposals) + "; " + decision
+	if killed == game.NoKill {
+		// 空刀：今晚没有狼刀目标，女巫不会被询问是否救人
+		m.broadcastToWerewolves(fmt.Sprintf(m.locale.Prompts.ToWolvesNoKill, details))
+		m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.WerewolvesEmptyKill, details))
+		m.logger.LogWerewolfNoKill(details)
+		return
+	}
+
+	m.state.SetNightKilled(killed)
+	if m.state.Round == 1 {
+		m.result.FirstNightKill = m.state.GetPlayerRole(killed)
 	}
+	m.broadcastToWerewolves(fmt.Sprintf(m.locale.Prompts.ToWolvesRes, details, killed))
+	m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.WerewolvesDecided, killed, details))
+	m.logger.LogWerewolfVote(killed, details)
 }
 
 // witchAction 女巫行动
@@ -187,48 +169,39 @@ func (m *ModeratorAgent) witchAction(ctx context.Context, gen *adk.AsyncGenerato
 	}
 
 	// 广播女巫轮次
-	m.broadcastToAll(params.Prompts.ToAllWitchTurn)
-	m.sendMessage(gen, fmt.Sprintf("  女巫 (%s) 正在决定...", witch))
+	m.broadcastToAll(m.locale.Prompts.ToAllWitchTurn)
+	m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.WitchDeciding, witch))
 	killed := m.state.GetNightKilled()
 	resurrected := false
 
 	// 救人决策
 	if killed != "" && m.state.CanUseHealingPotion() && killed != witch {
-		promptText := fmt.Sprintf(params.Prompts.ToWitchResurrect, witch, killed, killed)
-
-		saveTool := tools.NewSaveTool(m.state)
-		if saveTool != nil {
-			result, err := m.callPlayerWithTool(ctx, witch, promptText, saveTool)
-			if err == nil {
-				if save, ok := result["save"].(bool); ok && save {
-					m.state.SetNightSaved(true) // 内部会设置 HealingPotion = false
-					resurrected = true
-					m.broadcastToAll(params.Prompts.ToWitchResurrectYes)
-					m.sendMessage(gen, fmt.Sprintf("  ➡️ 女巫救了 %s！", killed))
-					m.logger.LogWitchSave(killed)
-				} else {
-					m.broadcastToAll(params.Prompts.ToWitchResurrectNo)
-				}
+		promptText := fmt.Sprintf(m.locale.Prompts.ToWitchResurrect, witch, killed, killed)
+
+		result, err := m.callPlayerWithTool(ctx, witch, promptText, actionTools[game.ActionSave]...)
+		if err == nil {
+			if save, ok := result["save"].(bool); ok && save {
+				m.state.SetNightSaved(true) // 内部会设置 HealingPotion = false
+				resurrected = true
+				m.result.HealingRound = m.state.Round
+				m.broadcastToAll(m.locale.Prompts.ToWitchResurrectYes)
+				m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.WitchSaved, killed))
+				m.logger.LogWitchSave(killed)
+			} else {
+				m.broadcastToAll(m.locale.Prompts.ToWitchResurrectNo)
 			}
 		}
 	}
 
 	// 毒人决策（同晚不能同时救毒）
 	if m.state.CanUsePoisonPotion() && !resurrected {
-		promptText := fmt.Sprintf(params.Prompts.ToWitchPoison, witch)
-
-		poisonTool := tools.NewPoisonTool(m.state)
-		if poisonTool != nil {
-			result, err := m.callPlayerWithTool(ctx, witch, promptText, poisonTool)
-			if err == nil {
-				if poison, ok := result["poison"].(bool); ok && poison {
-					if target, ok := result["target"].(string); ok && target != "" && target != witch {
-						m.state.SetNightPoisoned(target) // 内部会设置 PoisonPotion = false
-						m.sendMessage(gen, fmt.Sprintf("  ➡️ 女巫毒了 %s！", target))
-						m.logger.LogWitchPoison(target)
-					}
-				}
-			}
+		promptText := fmt.Sprintf(m.locale.Prompts.ToWitchPoison, witch)
+
+		if target, _ := m.decideTarget(ctx, gen, witch, game.ActionPoison, promptText, pickIf("poison")); target != "" {
+			m.state.SetNightPoisoned(target) // 内部会设置 PoisonPotion = false
+			m.result.PoisonRound = m.state.Round
+			m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.WitchPoisoned, target))
+			m.logger.LogWitchPoison(target)
 		}
 	}
 }
@@ -241,30 +214,17 @@ func (m *ModeratorAgent) seerAction(ctx context.Context, gen *adk.AsyncGenerator
 	}
 
 	// 广播预言家轮次
-	m.broadcastToAll(params.Prompts.ToAllSeerTurn)
-	m.sendMessage(gen, fmt.Sprintf("  预言家 (%s) 正在查验...", seer))
-	promptText := fmt.Sprintf(params.Prompts.ToSeer, seer)
-
-	// 使用结构化工具
-	checkTool := tools.NewCheckTool(m.state)
-	var target string
-
-	if checkTool != nil {
-		result, err := m.callPlayerWithTool(ctx, seer, promptText, checkTool)
-		if err == nil {
-			if t, ok := result["target"].(string); ok {
-				target = t
-			}
-		}
-	}
-
-	if target != "" {
-		player := m.state.Players[target]
-		result := string(player.Role)
-		resultMsg := fmt.Sprintf(params.Prompts.ToSeerResult, target, result)
+	m.broadcastToAll(m.locale.Prompts.ToAllSeerTurn)
+	m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.SeerChecking, seer))
+	promptText := fmt.Sprintf(m.locale.Prompts.ToSeer, seer)
+
+	if target, _ := m.decideTarget(ctx, gen, seer, game.ActionCheck, promptText, pickTarget); target != "" {
+		check := m.state.CheckIdentity(target)
+		result := m.locale.I18n.Log.CheckResultName(check)
+		resultMsg := fmt.Sprintf(m.locale.Prompts.ToSeerResult, target, result)
 		m.addToPlayerHistory(seer, schema.User, resultMsg)
-		m.sendMessage(gen, fmt.Sprintf("  ➡️ 预言家查验 %s: %s", target, result))
-		m.logger.LogSeerCheck(target, result)
+		m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.SeerResult, target, result))
+		m.logger.LogSeerCheck(check)
 	}
 }
 
@@ -295,17 +255,19 @@ func (m *ModeratorAgent) resolveNight(ctx context.Context, gen *adk.AsyncGenerat
 		saved = killed
 	}
 
-	if m.state.NightPoisoned != "" {
+	// 毒杀的目标可能已经死于狼刀（例如自刀的狼人），不重复结算
+	if m.state.NightPoisoned != "" && m.state.IsAlive(m.state.NightPoisoned) {
 		dead = append(dead, m.state.NightPoisoned)
 		m.state.KillPlayer(m.state.NightPoisoned)
 	}
 
 	m.logger.LogNightSummary(killed, m.state.NightPoisoned, saved, shot)
+	m.nightDead = dead
 
 	if len(dead) > 0 {
-		m.sendMessage(gen, fmt.Sprintf("  ☠️ 夜晚结算，死亡: %s", strings.Join(dead, ", ")))
+		m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.NightDeaths, strings.Join(dead, ", ")))
 	} else {
-		m.sendMessage(gen, "  ✨ 平安夜，无人死亡。")
+		m.sendMessage(gen, "  "+m.locale.I18n.PeacefulNight)
 	}
 }
 
@@ -322,43 +284,62 @@ func (m *ModeratorAgent) hunterShootNight(ctx context.Context, gen *adk.AsyncGen
 		return ""
 	}
 
-	promptText := fmt.Sprintf(params.Prompts.ToHunter, hunter)
+	promptText := fmt.Sprintf(m.locale.Prompts.ToHunter, hunter)
 
-	// 使用结构化工具
-	shootTool := tools.NewShootTool(m.state)
-	if shootTool != nil {
-		result, err := m.callPlayerWithTool(ctx, hunter, promptText, shootTool)
-		if err == nil {
-			if shoot, ok := result["shoot"].(bool); ok && shoot {
-				if target, ok := result["target"].(string); ok && target != "" {
-					m.sendMessage(gen, fmt.Sprintf("  🔫 猎人射杀了 %s！", target))
-					m.logger.LogHunterShoot(target)
-					return target
-				}
-			}
-		}
+	target, _ := m.decideTarget(ctx, gen, hunter, game.ActionShoot, promptText, pickIf("shoot"))
+	if target != "" {
+		m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.HunterShot, target))
+		m.logger.LogHunterShoot(target)
 	}
-	return ""
+	return target
 }
 
 // callPlayer 调用玩家（保留消息历史）
 func (m *ModeratorAgent) callPlayer(ctx context.Context, playerName, promptText string) string {
+	response, _, _ := m.runPlayer(ctx, nil, playerName, promptText)
+	return response
+}
+
+// runPlayer 调用玩家 Agent 并返回完整回复和本次调用中玩家发起的工具调用
+// gen 不为空时以流式调用，玩家生成的文本会逐段转发到主持人的事件流，第二个返回值表示是否转发过内容
+func (m *ModeratorAgent) runPlayer(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], playerName, promptText string) (string, bool, []schema.ToolCall) {
+	// 丢弃之前非公开场合提交的结构化发言，调用结束后暂存的内容只属于本次回复
+	m.state.DiscardClaim(playerName)
+
 	m.mu.Lock()
-	msgs := m.playerMsgs[playerName]
-	msgs = append(msgs, &schema.Message{Role: schema.User, Content: promptText})
+	prompt := &schema.Message{Role: schema.User, Content: promptText}
+	msgs := append(m.playerMsgs[playerName], prompt)
 	m.playerMsgs[playerName] = msgs
+	m.recordTranscript(playerName, prompt)
 	m.mu.Unlock()
 
 	agent := m.playerAgents[playerName]
 	if agent == nil {
-		return ""
+		return "", false, nil
 	}
 
+	role := string(m.state.GetPlayerRole(playerName))
+	ctx, span := telemetry.StartSpan(ctx, "player.call",
+		telemetry.AttrSeat.String(playerName),
+		telemetry.AttrRole.String(role),
+		telemetry.AttrRound.Int(m.state.Round),
+		telemetry.AttrPhase.String(m.state.GetPhase()),
+	)
+	start := time.Now()
+	var callErr error
+	defer func() {
+		telemetry.ObservePlayerCall(role, time.Since(start))
+		telemetry.EndSpan(span, callErr)
+	}()
+
 	iter := agent.Run(ctx, &adk.AgentInput{
-		Messages: msgs,
+		Messages:        msgs,
+		EnableStreaming: gen != nil,
 	})
 
 	var response string
+	var streamed bool
+	var calls []schema.ToolCall
 	for {
 		event, ok := iter.Next()
 		if !ok {
@@ -366,14 +347,41 @@ func (m *ModeratorAgent) callPlayer(ctx context.Context, playerName, promptText
 		}
 		// 处理错误事件
 		if event.Err != nil {
-			fmt.Printf("  ⚠️ [%s] 调用错误: %v\n", playerName, event.Err)
+			fmt.Printf("  "+m.locale.I18n.Error+"\n", playerName, event.Err)
+			telemetry.RecordError("player")
+			callErr = event.Err
+			continue
+		}
+		if event.Output == nil || event.Output.MessageOutput == nil {
 			continue
 		}
-		if event.Output != nil && event.Output.MessageOutput != nil {
-			if msg := event.Output.MessageOutput.Message; msg != nil && msg.Content != "" {
-				response = msg.Content
+		output := event.Output.MessageOutput
+		msg := output.Message
+		if output.IsStreaming {
+			var forwarded bool
+			var err error
+			msg, forwarded, err = m.forwardStream(gen, playerName, output)
+			streamed = streamed || forwarded
+			if err != nil {
+				fmt.Printf("  "+m.locale.I18n.Error+"\n", playerName, err)
+				telemetry.RecordError("player")
+				callErr = err
+				continue
 			}
 		}
+		if msg == nil {
+			continue
+		}
+		// 对话记录保留每一步的工具调用和结果，消息历史只保留最终回复
+		m.mu.Lock()
+		m.recordTranscript(playerName, msg)
+		m.mu.Unlock()
+		if msg.Role == schema.Assistant {
+			calls = append(calls, msg.ToolCalls...)
+		}
+		if msg.Content != "" {
+			response = msg.Content
+		}
 	}
 
 	// 保存响应到历史
@@ -384,21 +392,34 @@ func (m *ModeratorAgent) callPlayer(ctx context.Context, playerName, promptText
 		// 注意：日志记录由各个阶段的专门方法处理，避免重复
 	}
 
-	return response
+	// 本次调用中通过 belief 工具提交的概率判断
+	for _, b := range m.state.TakeBeliefs(playerName) {
+		m.logger.LogBelief(b)
+	}
+
+	return response, streamed, calls
 }
 
-// callPlayerWithTool 使用工具调用玩家
-// 注意：ADK 的 ChatModelAgent 会自动处理工具调用，返回的是工具执行后的最终响应
-func (m *ModeratorAgent) callPlayerWithTool(ctx context.Context, playerName, promptText string, _ interface{}) (map[string]interface{}, error) {
-	response := m.callPlayer(ctx, playerName, promptText)
+// callPlayerWithTool 调用玩家并取出行动决定
+// 玩家调用了 toolNames 中的工具时以最后一次调用的参数为准（由调用方校验），回复中的文字作为 message；
+// 没有调用这些工具时依次尝试把回复解析为 JSON 和从文本中猜测决定；弃票只认工具参数和 JSON，不从文字中猜测
+func (m *ModeratorAgent) callPlayerWithTool(ctx context.Context, playerName, promptText string, toolNames ...string) (map[string]interface{}, error) {
+	response, _, calls := m.runPlayer(ctx, nil, playerName, promptText)
+	if args := lastToolArgs(calls, toolNames); args != nil {
+		if _, ok := args["message"]; !ok && response != "" {
+			args["message"] = response
+		}
+		return args, nil
+	}
 	if response == "" {
 		return nil, fmt.Errorf("empty response")
 	}
 
-	// 尝试解析 JSON 响应（工具输出通常是 JSON 格式）
+	// 尝试解析 JSON 响应
 	var result map[string]interface{}
 	if err := json.Unmarshal([]byte(response), &result); err != nil {
 		// 如果不是 JSON，尝试从文本中提取关键信息
+		m.recordFallback()
 		result = make(map[string]interface{})
 		result["message"] = response
 		result["raw"] = response
@@ -406,12 +427,6 @@ func (m *ModeratorAgent) callPlayerWithTool(ctx context.Context, playerName, pro
 		// 尝试从文本中提取常见字段
 		responseLower := strings.ToLower(response)
 
-		// 检测是否同意/达成一致
-		if strings.Contains(responseLower, "agree") || strings.Contains(response, "同意") ||
-			strings.Contains(response, "一致") || strings.Contains(responseLower, "yes") {
-			result["reach_agreement"] = true
-		}
-
 		// 检测是否救人
 		if strings.Contains(responseLower, "save") || strings.Contains(response, "救") {
 			result["save"] = true
@@ -422,30 +437,61 @@ func (m *ModeratorAgent) callPlayerWithTool(ctx context.Context, playerName, pro
 			result["poison"] = true
 		}
 
+		// 检测是否空刀
+		if strings.Contains(responseLower, "no kill") || strings.Contains(response, "空刀") ||
+			strings.Contains(response, "襲撃なし") {
+			result["no_kill"] = true
+		}
+
 		// 检测是否开枪
 		if strings.Contains(responseLower, "shoot") || strings.Contains(response, "射") ||
 			strings.Contains(response, "开枪") {
 			result["shoot"] = true
 		}
 
-		// 尝试提取目标玩家名
-		for i := 1; i <= 9; i++ {
-			playerName := fmt.Sprintf("Player%d", i)
-			if strings.Contains(response, playerName) {
-				result["target"] = playerName
-				break
-			}
+		// 目标取回复中第一个出现的本局座位
+		if target := m.firstSeat(response); target != "" {
+			result["target"] = target
 		}
 	}
 
 	return result, nil
 }
 
+// lastToolArgs 返回 calls 中最后一次调用 toolNames 中工具的参数，没有或参数无法解析时返回 nil
+func lastToolArgs(calls []schema.ToolCall, toolNames []string) map[string]interface{} {
+	for i := len(calls) - 1; i >= 0; i-- {
+		if !slices.Contains(toolNames, calls[i].Function.Name) {
+			continue
+		}
+		var args map[string]interface{}
+		if err := json.Unmarshal([]byte(calls[i].Function.Arguments), &args); err == nil && args != nil {
+			return args
+		}
+	}
+	return nil
+}
+
+// seatPattern 匹配文本中的座位名，Player10 整体匹配，不会被当成 Player1
+var seatPattern = regexp.MustCompile(`Player\d+`)
+
+// firstSeat 返回文本中按位置第一个出现的本局座位，没有时返回空字符串
+func (m *ModeratorAgent) firstSeat(text string) string {
+	for _, name := range seatPattern.FindAllString(text, -1) {
+		if m.state.HasPlayer(name) {
+			return name
+		}
+	}
+	return ""
+}
+
 // addToPlayerHistory 添加消息到玩家历史
 func (m *ModeratorAgent) addToPlayerHistory(playerName string, role schema.RoleType, content string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.playerMsgs[playerName] = append(m.playerMsgs[playerName], &schema.Message{Role: role, Content: content})
+	msg := &schema.Message{Role: role, Content: content}
+	m.playerMsgs[playerName] = append(m.playerMsgs[playerName], msg)
+	m.recordTranscript(playerName, msg)
 }
 
 // broadcastToWerewolves 广播消息给所有狼人
@@ -467,13 +513,12 @@ func (m *ModeratorAgent) formatWerewolfHistory(wolf string) string {
 	}
 
 	var history strings.Builder
-	history.WriteString("\n\n[Previous discussion]:\n")
 	for _, msg := range msgs[1:] { // 跳过系统消息
 		if msg.Role == schema.User {
-			history.WriteString(fmt.Sprintf("Moderator: %s\n", utils.Truncate(msg.Content, 100)))
+			history.WriteString(fmt.Sprintf(m.locale.Prompts.ToHistoryModerator, utils.Truncate(msg.Content, 100)))
 		} else if msg.Role == schema.Assistant {
-			history.WriteString(fmt.Sprintf("You: %s\n", utils.Truncate(msg.Content, 100)))
+			history.WriteString(fmt.Sprintf(m.locale.Prompts.ToHistorySelf, utils.Truncate(msg.Content, 100)))
 		}
 	}
-	return history.String()
+	return fmt.Sprintf(m.locale.Prompts.ToWolvesHistory, history.String())
 }
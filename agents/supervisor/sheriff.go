@@ -0,0 +1,89 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/adk"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/utils"
+)
+
+// electSheriff 第一个白天讨论前由全体存活玩家投票选出警长，得票最多者当选
+// 没有有效票时本局没有警长，发言顺序退化为从最近死者开始顺时针
+func (m *ModeratorAgent) electSheriff(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], alivePlayers []string) {
+	query := fmt.Sprintf(m.locale.Prompts.ToSheriffElection, strings.Join(alivePlayers, ", "))
+	m.broadcastToAll(query)
+
+	votes := make(map[string]string)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for _, player := range alivePlayers {
+		wg.Add(1)
+		go func(p string) {
+			defer wg.Done()
+			target, _ := m.decideTarget(ctx, gen, p, game.ActionSheriff, query, pickTarget)
+			if target == "" {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			votes[p] = target
+			m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.PlayerVotes, p, target))
+		}(player)
+	}
+	wg.Wait()
+
+	sheriff, tied, tally := utils.MajorityVote(votes, alivePlayers, m.rng)
+	msg := fmt.Sprintf(m.locale.Prompts.ToAllNoSheriff, tally)
+	if sheriff != "" {
+		msg = fmt.Sprintf(m.locale.Prompts.ToAllSheriff, tally+m.tieNote(tied, sheriff), sheriff)
+	}
+	m.state.SetSheriff(sheriff)
+	m.announce(gen, msg)
+}
+
+// passBadge 警长出局后移交警徽，警长不给出合法目标时撕掉警徽
+func (m *ModeratorAgent) passBadge(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent]) {
+	sheriff := m.state.GetSheriff()
+	if sheriff == "" || m.state.IsAlive(sheriff) {
+		return
+	}
+
+	alivePlayers := m.state.GetAlivePlayers()
+	prompt := fmt.Sprintf(m.locale.Prompts.ToSheriffPass, sheriff, strings.Join(alivePlayers, ", "))
+	successor, _ := m.decideTarget(ctx, gen, sheriff, game.ActionSheriff, prompt, pickTarget)
+
+	msg := fmt.Sprintf(m.locale.Prompts.ToAllBadgeTorn, sheriff)
+	if successor != "" {
+		msg = fmt.Sprintf(m.locale.Prompts.ToAllBadgePassed, sheriff, successor)
+	}
+	m.state.SetSheriff(successor)
+	m.announce(gen, msg)
+}
+
+// announce 向所有玩家公布主持人消息，并输出到控制台和日志
+func (m *ModeratorAgent) announce(gen *adk.AsyncGenerator[*adk.AgentEvent], msg string) {
+	m.broadcastToAll(msg)
+	m.sendMessage(gen, "  "+fmt.Sprintf(m.locale.I18n.Announcement, msg))
+	m.logger.LogModerator(msg)
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+func TestElectSheriff(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleVillager, game.RoleVillager, game.RoleSeer, game.RoleWitch}
+	vote := func(target string) []string { return []string{`{"target":"` + target + `"}`} }
+
+	tests := []struct {
+		name    string
+		replies map[string][]string
+		want    string
+	}{
+		{"得票最多者当选", map[string][]string{
+			"Player1": vote("Player2"), "Player2": vote("Player4"), "Player3": vote("Player4"), "Player4": vote("Player3"), "Player5": vote("Player4"),
+		}, "Player4"},
+		{"投给自己不计票", map[string][]string{
+			"Player1": vote("Player1"), "Player2": vote("Player1"), "Player3": vote("Player3"), "Player4": vote("Player3"), "Player5": vote("Player1"),
+		}, "Player1"},
+		{"没有有效票", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModerator(t, params.DefaultBoard, roles, tt.replies)
+			drive(func(gen *adkGen) { m.electSheriff(context.Background(), gen, m.state.GetAlivePlayers()) })
+			if got := m.state.GetSheriff(); got != tt.want {
+				t.Errorf("警长 %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPassBadge(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleVillager, game.RoleVillager, game.RoleSeer}
+
+	tests := []struct {
+		name    string
+		dead    string
+		replies []string // 警长的回复
+		want    string
+	}{
+		{"警长存活时不移交", "", []string{`{"target":"Player3"}`}, "Player2"},
+		{"移交给存活玩家", "Player2", []string{`{"target":"Player3"}`}, "Player3"},
+		{"重新选择后移交", "Player2", []string{`{"target":"Player1"}`, `{"target":"Player4"}`}, "Player4"},
+		{"没有选择时撕掉警徽", "Player2", []string{`{}`}, ""},
+		{"一直不合法时撕掉警徽", "Player2", []string{`{"target":"Player2"}`}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModerator(t, params.DefaultBoard, roles, map[string][]string{"Player2": tt.replies})
+			m.state.SetSheriff("Player2")
+			m.state.KillPlayer("Player1")
+			if tt.dead != "" {
+				m.state.KillPlayer(tt.dead)
+			}
+
+			drive(func(gen *adkGen) { m.passBadge(context.Background(), gen) })
+			if got := m.state.GetSheriff(); got != tt.want {
+				t.Errorf("警长 %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/schema"
+)
+
+// streamBuffer 转发流的缓冲大小，消费方读得慢时玩家调用会等待
+const streamBuffer = 16
+
+// speak 调用玩家进行公开发言
+// 开启流式输出时发言会逐段转发给控制台和观众，返回值表示发言是否已经以流的形式展示过
+func (m *ModeratorAgent) speak(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], player, prompt string) (string, bool) {
+	if !m.streaming {
+		return m.callPlayer(ctx, player, prompt), false
+	}
+	response, streamed, _ := m.runPlayer(ctx, gen, player, prompt)
+	return response, streamed
+}
+
+// forwardStream 读取玩家的流式输出并拼接成完整消息
+// gen 不为空时把助手生成的文本逐段转发为以玩家命名的流式事件，工具调用片段和工具结果不转发
+func (m *ModeratorAgent) forwardStream(gen *adk.AsyncGenerator[*adk.AgentEvent], player string, output *adk.MessageVariant) (*schema.Message, bool, error) {
+	sr := output.MessageStream
+	if sr == nil {
+		return output.Message, false, nil
+	}
+	defer sr.Close()
+
+	forward := gen != nil && output.Role == schema.Assistant
+	var w *schema.StreamWriter[*schema.Message]
+	defer func() {
+		if w != nil {
+			w.Close()
+		}
+	}()
+
+	var chunks []*schema.Message
+	for {
+		chunk, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, w != nil, err
+		}
+		chunks = append(chunks, chunk)
+		if !forward || chunk.Content == "" {
+			continue
+		}
+		if w == nil {
+			var r *schema.StreamReader[*schema.Message]
+			r, w = schema.Pipe[*schema.Message](streamBuffer)
+			gen.Send(&adk.AgentEvent{
+				AgentName: player,
+				Output: &adk.AgentOutput{
+					MessageOutput: &adk.MessageVariant{
+						IsStreaming:   true,
+						MessageStream: r,
+						Role:          schema.Assistant,
+					},
+				},
+			})
+		}
+		if w.Send(&schema.Message{Role: schema.Assistant, Content: chunk.Content}, nil) {
+			// 消费方已关闭转发流，剩余内容只用于拼接完整消息
+			forward = false
+		}
+	}
+
+	if len(chunks) == 0 {
+		return nil, w != nil, nil
+	}
+	msg, err := schema.ConcatMessages(chunks)
+	return msg, w != nil, err
+}
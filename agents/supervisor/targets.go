@@ -0,0 +1,100 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudwego/eino/adk"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// actionTools 每种行动从哪些工具的调用参数中读取玩家的决定
+var actionTools = map[game.Action][]string{
+	game.ActionWolfVote: {"discuss", "kill", "vote"},
+	game.ActionCheck:    {"check_identity"},
+	game.ActionSave:     {"save"},
+	game.ActionPoison:   {"poison"},
+	game.ActionShoot:    {"shoot"},
+	game.ActionVote:     {"vote"},
+	game.ActionSheriff:  {"vote"},
+}
+
+// decideTarget 询问玩家行动目标并校验是否合法
+// pick 从工具调用参数（或回退解析的结果）中取出目标，第二个返回值为 false 表示玩家明确选择不行动；
+// 目标不合法时把原因和可选目标告诉玩家重新询问，最多 params.MaxTargetRetries 次，仍不合法则行动作废并记录 forfeit 事件
+// （白天投票由投票阶段记为废票）。
+// 返回合法目标；玩家选择不行动时返回空目标和 nil；玩家没有回复时返回调用错误，仍不合法时返回最后一次的 *game.TargetError
+func (m *ModeratorAgent) decideTarget(ctx context.Context, gen *adk.AsyncGenerator[*adk.AgentEvent], player string, action game.Action, prompt string, pick func(map[string]interface{}) (string, bool)) (string, error) {
+	for attempt := 0; ; attempt++ {
+		result, err := m.callPlayerWithTool(ctx, player, prompt, actionTools[action]...)
+		if err != nil {
+			return "", err
+		}
+		target, act := pick(result)
+		if !act {
+			return "", nil
+		}
+
+		err = m.state.CheckTarget(action, player, target)
+		if err == nil {
+			return target, nil
+		}
+		var illegal *game.TargetError
+		if !errors.As(err, &illegal) || attempt >= params.MaxTargetRetries {
+			rejected := fmt.Sprintf(m.locale.I18n.TargetRejected, player, strconv.Quote(target))
+			m.sendMessage(gen, "  "+rejected)
+			m.logger.LogModerator(rejected)
+			if illegal != nil && action != game.ActionVote {
+				m.logger.LogForfeit(player, action, target, illegal.Reason)
+			}
+			return "", err
+		}
+		prompt = m.locale.IllegalTarget(m.state, illegal)
+	}
+}
+
+// pickTarget 取出工具结果中的 target，没有目标时视为不行动
+func pickTarget(result map[string]interface{}) (string, bool) {
+	target, _ := result["target"].(string)
+	return target, target != ""
+}
+
+// pickIf 返回只在工具结果中的 flag 为 true 时才行动的 pick 函数，用于毒药和开枪这类可以放弃的行动
+func pickIf(flag string) func(map[string]interface{}) (string, bool) {
+	return func(result map[string]interface{}) (string, bool) {
+		if ok, _ := result[flag].(bool); !ok {
+			return "", false
+		}
+		target, _ := result["target"].(string)
+		return target, true
+	}
+}
+
+// pickBallot 取出白天投票的目标，没有目标且 abstain 为 true 时视为弃票
+func pickBallot(result map[string]interface{}) (string, bool) {
+	target, _ := result["target"].(string)
+	if abstain, _ := result["abstain"].(bool); abstain && target == "" {
+		return "", false
+	}
+	return target, true
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+func TestDecideTarget(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleWerewolf, game.RoleSeer, game.RoleWitch, game.RoleHunter, game.RoleVillager}
+	locale := params.NewLocale("zh")
+	reprompt := fmt.Sprintf(locale.Prompts.ToIllegalTarget, `"Player3"`, locale.Prompts.ToIllegalSelf, "Player1, Player2, Player4, Player5")
+
+	tests := []struct {
+		name      string
+		replies   []string
+		want      string
+		wantErr   game.Violation // 期望最终返回的不合法原因
+		noReply   bool           // 期望返回调用错误
+		wantCalls int
+	}{
+		{"第一次就合法", []string{`{"target":"Player1"}`}, "Player1", "", false, 1},
+		{"重新选择后合法", []string{`{"target":"Player3"}`, `{"target":"Player2"}`}, "Player2", "", false, 2},
+		{"最后一次机会合法", []string{`{"target":"Player3"}`, `{"target":"Player6"}`, `{"target":"Player1"}`}, "Player1", "", false, params.MaxTargetRetries + 1},
+		{"一直不合法", []string{`{"target":"Player3"}`}, "", game.ViolationSelf, false, params.MaxTargetRetries + 1},
+		{"最后一次的原因", []string{`{"target":"Player3"}`, `{"target":"Player3"}`, `{"target":"Player6"}`}, "", game.ViolationDead, false, params.MaxTargetRetries + 1},
+		{"选择不行动", []string{`{}`}, "", "", false, 1},
+		{"没有回复", []string{""}, "", "", true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModerator(t, params.DefaultBoard, roles, map[string][]string{"Player3": tt.replies})
+			m.state.KillPlayer("Player6")
+			seer := m.playerAgents["Player3"].(*scriptedAgent)
+
+			var got string
+			var err error
+			drive(func(gen *adkGen) {
+				got, err = m.decideTarget(context.Background(), gen, "Player3", game.ActionCheck, "prompt", pickTarget)
+			})
+
+			if got != tt.want {
+				t.Errorf("decideTarget() = %q，期望 %q", got, tt.want)
+			}
+			var illegal *game.TargetError
+			switch {
+			case tt.wantErr != "":
+				if !errors.As(err, &illegal) || illegal.Reason != tt.wantErr {
+					t.Errorf("decideTarget() 错误 = %v，期望原因 %s", err, tt.wantErr)
+				}
+			case tt.noReply:
+				if err == nil || errors.As(err, &illegal) {
+					t.Errorf("decideTarget() 错误 = %v，期望调用错误", err)
+				}
+			case err != nil:
+				t.Errorf("decideTarget() 错误 = %v，期望 nil", err)
+			}
+			if seer.calls != tt.wantCalls {
+				t.Errorf("询问了 %d 次，期望 %d 次", seer.calls, tt.wantCalls)
+			}
+			if len(seer.prompts) > 1 && seer.prompts[1] != reprompt {
+				t.Errorf("重新询问的提示 = %q，期望 %q", seer.prompts[1], reprompt)
+			}
+		})
+	}
+}
+
+func TestDecideTargetReadsToolCall(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleWerewolf, game.RoleSeer, game.RoleWitch, game.RoleHunter, game.RoleVillager}
+	tests := []struct {
+		name  string
+		reply string
+		calls []schema.ToolCall
+		want  string
+	}{
+		{"工具参数优先于文字", "我查验 Player1，Player2 也很可疑", []schema.ToolCall{toolCall("check_identity", `{"target":"Player2"}`)}, "Player2"},
+		{"以最后一次调用为准", "决定了", []schema.ToolCall{toolCall("check_identity", `{"target":"Player1"}`), toolCall("check_identity", `{"target":"Player4"}`)}, "Player4"},
+		{"忽略其他工具", "Player5 最可疑", []schema.ToolCall{toolCall("belief", `{"probs":{"Player1":0.9}}`)}, "Player5"},
+		{"文字回退取第一个出现的座位", "Player4 比 Player2 更可疑", nil, "Player4"},
+		{"文字回退跳过不在本局的座位", "Player10 不存在，查 Player5", nil, "Player5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModerator(t, params.DefaultBoard, roles, map[string][]string{"Player3": {tt.reply}})
+			m.playerAgents["Player3"].(*scriptedAgent).toolCalls = [][]schema.ToolCall{tt.calls}
+
+			var got string
+			drive(func(gen *adkGen) {
+				got, _ = m.decideTarget(context.Background(), gen, "Player3", game.ActionCheck, "prompt", pickTarget)
+			})
+			if got != tt.want {
+				t.Errorf("decideTarget() = %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecideTargetRecordsForfeit(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleWerewolf, game.RoleSeer, game.RoleWitch, game.RoleHunter, game.RoleVillager}
+	m := newTestModerator(t, params.DefaultBoard, roles, map[string][]string{"Player3": {`{"target":"Player3"}`}})
+
+	drive(func(gen *adkGen) {
+		m.decideTarget(context.Background(), gen, "Player3", game.ActionCheck, "prompt", pickTarget)
+	})
+
+	var forfeits []game.Event
+	for _, e := range m.logger.Events() {
+		if e.Type == game.EventForfeit {
+			forfeits = append(forfeits, e)
+		}
+	}
+	want := game.Event{Type: game.EventForfeit, Actor: "Player3", Action: game.ActionCheck, Target: "Player3", Content: string(game.ViolationSelf)}
+	if len(forfeits) != 1 || forfeits[0].Actor != want.Actor || forfeits[0].Action != want.Action ||
+		forfeits[0].Target != want.Target || forfeits[0].Content != want.Content {
+		t.Errorf("forfeit 事件 = %+v，期望一条 %+v", forfeits, want)
+	}
+}
+
+func TestPickers(t *testing.T) {
+	tests := []struct {
+		name   string
+		pick   func(map[string]interface{}) (string, bool)
+		result map[string]interface{}
+		want   string
+		act    bool
+	}{
+		{"pickTarget 有目标", pickTarget, map[string]interface{}{"target": "Player1"}, "Player1", true},
+		{"pickTarget 没有目标", pickTarget, map[string]interface{}{}, "", false},
+		{"pickIf 没有开枪", pickIf("shoot"), map[string]interface{}{"target": "Player1"}, "", false},
+		{"pickIf 开枪", pickIf("shoot"), map[string]interface{}{"shoot": true, "target": "Player1"}, "Player1", true},
+		{"pickIf 开枪没有目标", pickIf("shoot"), map[string]interface{}{"shoot": true}, "", true},
+		{"pickBallot 投票", pickBallot, map[string]interface{}{"target": "Player1"}, "Player1", true},
+		{"pickBallot 弃票", pickBallot, map[string]interface{}{"abstain": true}, "", false},
+		{"pickBallot 弃票又给了目标", pickBallot, map[string]interface{}{"abstain": true, "target": "Player1"}, "Player1", true},
+		{"pickBallot 什么都没给", pickBallot, map[string]interface{}{}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, act := tt.pick(tt.result)
+			if got != tt.want || act != tt.act {
+				t.Errorf("pick() = (%q, %v)，期望 (%q, %v)", got, act, tt.want, tt.act)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+	"github.com/ashwinyue/wolf-go-adk/telemetry"
+)
+
+func TestGameSpansNest(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := telemetry.NewTracerProvider(exporter)
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		_ = tp.Shutdown(context.Background())
+	})
+
+	// 狼人第一晚刀 Player2，白天好人一起投出狼人，一个回合结束
+	m := newTestModerator(t, params.DefaultBoard,
+		[]game.Role{game.RoleWerewolf, game.RoleVillager, game.RoleSeer, game.RoleVillager},
+		map[string][]string{
+			"Player1": {`{"target":"Player2","message":"刀 2 号"}`, `{"target":"Player3"}`},
+			"Player3": {`{"target":"Player1"}`},
+			"Player4": {`{"target":"Player1"}`},
+		})
+
+	iter := m.Run(context.Background(), nil)
+	for {
+		if _, ok := iter.Next(); !ok {
+			break
+		}
+	}
+	if err := tp.ForceFlush(context.Background()); err != nil {
+		t.Fatalf("ForceFlush: %v", err)
+	}
+	spans := exporter.GetSpans()
+
+	byID := make(map[string]tracetest.SpanStub)
+	for _, s := range spans {
+		byID[s.SpanContext.SpanID().String()] = s
+	}
+	parent := func(s tracetest.SpanStub) string {
+		if p, ok := byID[s.Parent.SpanID().String()]; ok {
+			return p.Name
+		}
+		return ""
+	}
+	str := func(s tracetest.SpanStub, key attribute.Key) string {
+		for _, kv := range s.Attributes {
+			if kv.Key == key {
+				return kv.Value.Emit()
+			}
+		}
+		return ""
+	}
+
+	counts := make(map[string]int)
+	var wolfCall bool
+	for _, s := range spans {
+		counts[s.Name]++
+		switch s.Name {
+		case "round":
+			if parent(s) != "game" {
+				t.Errorf("round 的父 span 是 %q", parent(s))
+			}
+		case "phase.night", "phase.day", "phase.reflection":
+			if parent(s) != "round" {
+				t.Errorf("%s 的父 span 是 %q", s.Name, parent(s))
+			}
+		case "player.call":
+			if p := parent(s); p != "phase.night" && p != "phase.day" && p != "phase.reflection" {
+				t.Errorf("player.call 的父 span 是 %q", p)
+			}
+			if str(s, telemetry.AttrSeat) == "" || str(s, telemetry.AttrRole) == "" {
+				t.Errorf("player.call 缺少座位或角色属性")
+			}
+			if str(s, telemetry.AttrSeat) == "Player1" && str(s, telemetry.AttrPhase) == "night" {
+				wolfCall = str(s, telemetry.AttrRole) == string(game.RoleWerewolf)
+			}
+		}
+	}
+	for _, name := range []string{"game", "round", "phase.night", "phase.day", "phase.reflection"} {
+		if counts[name] != 1 {
+			t.Errorf("%s span 有 %d 个，期望 1 个", name, counts[name])
+		}
+	}
+	if counts["player.call"] == 0 {
+		t.Errorf("没有 player.call span")
+	}
+	if !wolfCall {
+		t.Errorf("没有找到 Player1 夜间以狼人身份的调用")
+	}
+
+	gameSpan := spans[len(spans)-1]
+	if gameSpan.Name != "game" {
+		t.Fatalf("最后结束的 span 是 %q，期望 game", gameSpan.Name)
+	}
+	if got := str(gameSpan, telemetry.AttrWinner); got != string(m.result.Winner) || got == "" {
+		t.Errorf("winner = %q，期望 %q", got, m.result.Winner)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+// recordTranscript 追加玩家的对话记录，调用方需持有 m.mu
+func (m *ModeratorAgent) recordTranscript(player string, msgs ...*schema.Message) {
+	for _, msg := range msgs {
+		m.transcripts[player] = append(m.transcripts[player], transcriptMessage(msg))
+	}
+}
+
+// saveTranscripts 按座位顺序导出每名玩家的对话记录
+func (m *ModeratorAgent) saveTranscripts() {
+	m.mu.RLock()
+	transcripts := make([]game.Transcript, 0, len(m.state.Seats))
+	for _, seat := range m.state.Seats {
+		msgs := make([]game.TranscriptMessage, len(m.transcripts[seat]))
+		copy(msgs, m.transcripts[seat])
+		transcripts = append(transcripts, game.Transcript{
+			Seat:     seat,
+			Role:     m.state.GetPlayerRole(seat),
+			Messages: msgs,
+		})
+	}
+	m.mu.RUnlock()
+
+	if err := m.logger.SaveTranscripts(transcripts); err != nil {
+		fmt.Printf("⚠️ %v\n", err)
+	}
+}
+
+// transcriptMessage 把模型消息转换为对话记录中的消息
+func transcriptMessage(msg *schema.Message) game.TranscriptMessage {
+	tm := game.TranscriptMessage{
+		Role:     string(msg.Role),
+		Content:  msg.Content,
+		ToolName: msg.ToolName,
+	}
+	for _, call := range msg.ToolCalls {
+		tm.ToolCalls = append(tm.ToolCalls, game.ToolCall{Name: call.Function.Name, Arguments: call.Function.Arguments})
+	}
+	return tm
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+func TestVotePhase(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleVillager, game.RoleVillager, game.RoleVillager, game.RoleVillager}
+	vote := func(target string) []string { return []string{`{"target":"` + target + `"}`} }
+	abstain := []string{`{"abstain":true}`}
+
+	tests := []struct {
+		name      string
+		quorum    float64
+		replies   map[string][]string
+		votedOut  string
+		abstained []string
+		spoiled   map[string]string // 废票玩家 → 原因
+	}{
+		{"多数放逐", 0, map[string][]string{
+			"Player1": vote("Player2"), "Player2": vote("Player1"), "Player3": vote("Player1"), "Player4": vote("Player1"), "Player5": abstain,
+		}, "Player1", []string{"Player5"}, nil},
+		{"全部弃票", 0, map[string][]string{
+			"Player1": abstain, "Player2": abstain, "Player3": abstain, "Player4": abstain, "Player5": abstain,
+		}, "", []string{"Player1", "Player2", "Player3", "Player4", "Player5"}, nil},
+		{"废票不计入有效票", 0, map[string][]string{
+			"Player1": vote("Player1"), "Player2": {""}, "Player3": vote("Player9"), "Player4": vote("Player1"), "Player5": abstain,
+		}, "Player1", []string{"Player5"}, map[string]string{
+			"Player1": string(game.ViolationSelf), "Player2": game.SpoiledNoResponse, "Player3": string(game.ViolationUnknown),
+		}},
+		{"有效票不足", 0.6, map[string][]string{
+			"Player1": vote("Player2"), "Player2": vote("Player1"), "Player3": abstain, "Player4": abstain, "Player5": {""},
+		}, "", []string{"Player3", "Player4"}, map[string]string{"Player5": game.SpoiledNoResponse}},
+		{"弃票和废票都不计入有效票", 0.6, map[string][]string{
+			"Player1": vote("Player2"), "Player2": vote("Player1"), "Player3": vote("Player1"), "Player4": abstain, "Player5": vote("Player5"),
+		}, "Player1", []string{"Player4"}, map[string]string{"Player5": string(game.ViolationSelf)}},
+		{"弃票和废票导致有效票不足", 0.6, map[string][]string{
+			"Player1": vote("Player2"), "Player2": vote("Player1"), "Player3": abstain, "Player4": vote("Player4"), "Player5": {""},
+		}, "", []string{"Player3"}, map[string]string{"Player4": string(game.ViolationSelf), "Player5": game.SpoiledNoResponse}},
+		{"文字中提到弃票不算弃票", 0, map[string][]string{
+			"Player1": {"I won't abstain, I vote Player3"}, "Player2": vote("Player3"), "Player3": vote("Player1"), "Player4": abstain, "Player5": abstain,
+		}, "Player3", []string{"Player4", "Player5"}, nil},
+		{"恰好达到有效票数", 0.6, map[string][]string{
+			"Player1": vote("Player2"), "Player2": vote("Player1"), "Player3": vote("Player1"), "Player4": abstain, "Player5": abstain,
+		}, "Player1", []string{"Player4", "Player5"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := params.DefaultBoard
+			board.VoteQuorum = tt.quorum
+			m := newTestModerator(t, board, roles, tt.replies)
+			alive := m.state.GetAlivePlayers()
+
+			drive(func(gen *adkGen) { m.votePhase(context.Background(), gen, alive) })
+
+			votedOut := "-"
+			var abstained []string
+			spoiled := make(map[string]string)
+			for _, e := range m.logger.Events() {
+				switch e.Type {
+				case game.EventVoteResult:
+					votedOut = e.Target
+				case game.EventAbstain:
+					abstained = append(abstained, e.Actor)
+				case game.EventSpoiled:
+					spoiled[e.Actor] = e.Content
+				}
+			}
+			if votedOut != tt.votedOut {
+				t.Errorf("放逐 %q，期望 %q", votedOut, tt.votedOut)
+			}
+			if tt.votedOut != "" && m.state.IsAlive(tt.votedOut) {
+				t.Errorf("%s 被放逐后仍然存活", tt.votedOut)
+			}
+			if !sameMembers(abstained, tt.abstained) {
+				t.Errorf("弃票 %v，期望 %v", abstained, tt.abstained)
+			}
+			if !maps.Equal(spoiled, tt.spoiled) {
+				t.Errorf("废票 %v，期望 %v", spoiled, tt.spoiled)
+			}
+		})
+	}
+}
+
+func TestVotePhaseTie(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleVillager, game.RoleVillager, game.RoleVillager, game.RoleVillager}
+	replies := map[string][]string{
+		"Player1": {`{"target":"Player4"}`}, "Player2": {`{"target":"Player4"}`},
+		"Player3": {`{"target":"Player2"}`}, "Player4": {`{"target":"Player2"}`}, "Player5": {`{"abstain":true}`},
+	}
+
+	run := func() (string, string) {
+		m := newTestModerator(t, params.DefaultBoard, roles, replies)
+		drive(func(gen *adkGen) { m.votePhase(context.Background(), gen, m.state.GetAlivePlayers()) })
+		for _, e := range m.logger.Events() {
+			if e.Type == game.EventVoteResult {
+				return e.Target, e.Content
+			}
+		}
+		return "", ""
+	}
+
+	votedOut, details := run()
+	if votedOut != "Player2" && votedOut != "Player4" {
+		t.Fatalf("平票放逐 %q，期望 Player2 或 Player4", votedOut)
+	}
+	locale := params.NewLocale("zh")
+	want := "Player2:2, Player4:2; " + fmt.Sprintf(locale.Prompts.ToVoteAbstain, "Player5") + "; " +
+		fmt.Sprintf(locale.Prompts.ToVoteTie, "Player2, Player4", votedOut)
+	if details != want {
+		t.Errorf("票型 = %q，期望 %q", details, want)
+	}
+	for i := 0; i < 5; i++ {
+		if again, _ := run(); again != votedOut {
+			t.Fatalf("相同种子平票放逐 %q 和 %q，期望相同", votedOut, again)
+		}
+	}
+}
+
+// sameMembers 判断两个列表的元素是否相同，忽略顺序
+func sameMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]int)
+	for _, s := range a {
+		seen[s]++
+	}
+	for _, s := range b {
+		if seen[s] == 0 {
+			return false
+		}
+		seen[s]--
+	}
+	return true
+}
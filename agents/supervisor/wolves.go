@@ -0,0 +1,93 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// unanimousProposal 所有狼人都提议了同一个目标时返回该目标，否则返回空
+func unanimousProposal(wolves []string, proposals map[string]string) string {
+	target := ""
+	for _, wolf := range wolves {
+		proposal := proposals[wolf]
+		if proposal == "" || (target != "" && proposal != target) {
+			return ""
+		}
+		target = proposal
+	}
+	return target
+}
+
+// resolveProposals 狼人未达成一致时按板子规则从当前提议中决定击杀目标，返回目标和决定方式的说明
+// 没有任何提议时返回空目标
+func (m *ModeratorAgent) resolveProposals(wolves []string, proposals map[string]string) (string, string) {
+	var candidates []string
+	for _, wolf := range wolves {
+		if target, ok := proposals[wolf]; ok {
+			candidates = append(candidates, target)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", ""
+	}
+
+	switch m.board.WolfRule() {
+	case params.WolfLeader:
+		// 狼首没有提议时退回多数规则
+		if target, ok := proposals[wolves[0]]; ok {
+			return target, fmt.Sprintf(m.locale.Prompts.ToWolvesLeader, wolves[0])
+		}
+	case params.WolfRandom:
+		return candidates[m.rng.Intn(len(candidates))], m.locale.Prompts.ToWolvesRandom
+	}
+
+	// 多数规则：取得票最多的目标，平票时取发言顺序最靠前的狼人的提议
+	counts := make(map[string]int)
+	for _, target := range candidates {
+		counts[target]++
+	}
+	best := ""
+	for _, target := range candidates {
+		if best == "" || counts[target] > counts[best] {
+			best = target
+		}
+	}
+	return best, m.locale.Prompts.ToWolvesMajority
+}
+
+// formatProposals 格式化当前提议，附在狼人讨论提示之后；还没有提议时返回空
+func (m *ModeratorAgent) formatProposals(wolves []string, proposals map[string]string) string {
+	if len(proposals) == 0 {
+		return ""
+	}
+	return "\n\n" + fmt.Sprintf(m.locale.Prompts.ToWolvesProposals, proposalList(wolves, proposals))
+}
+
+// proposalList 按发言顺序列出各狼人的提议，如 Player1→Player5, Player2→Player7
+func proposalList(wolves []string, proposals map[string]string) string {
+	var parts []string
+	for _, wolf := range wolves {
+		if target, ok := proposals[wolf]; ok {
+			parts = append(parts, wolf+"→"+target)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
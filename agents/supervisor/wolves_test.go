@@ -0,0 +1,190 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+func TestUnanimousProposal(t *testing.T) {
+	wolves := []string{"Player1", "Player2", "Player3"}
+	tests := []struct {
+		name      string
+		proposals map[string]string
+		want      string
+	}{
+		{"全部相同", map[string]string{"Player1": "Player5", "Player2": "Player5", "Player3": "Player5"}, "Player5"},
+		{"有人不同", map[string]string{"Player1": "Player5", "Player2": "Player6", "Player3": "Player5"}, ""},
+		{"有人没提议", map[string]string{"Player1": "Player5", "Player2": "Player5"}, ""},
+		{"都没提议", map[string]string{}, ""},
+		{"一致空刀", map[string]string{"Player1": game.NoKill, "Player2": game.NoKill, "Player3": game.NoKill}, game.NoKill},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unanimousProposal(wolves, tt.proposals); got != tt.want {
+				t.Errorf("unanimousProposal() = %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveProposals(t *testing.T) {
+	three := []string{"Player1", "Player2", "Player3"}
+	four := []string{"Player1", "Player2", "Player3", "Player4"}
+	locale := params.NewLocale("zh")
+
+	tests := []struct {
+		name      string
+		rule      params.WolfDecisionRule
+		wolves    []string
+		proposals map[string]string
+		want      string
+		decision  string
+	}{
+		{"多数", params.WolfMajority, three,
+			map[string]string{"Player1": "Player5", "Player2": "Player6", "Player3": "Player6"}, "Player6", locale.Prompts.ToWolvesMajority},
+		{"三方平票取第一个狼人", params.WolfMajority, three,
+			map[string]string{"Player1": "Player5", "Player2": "Player6", "Player3": "Player7"}, "Player5", locale.Prompts.ToWolvesMajority},
+		{"两两平票取发言靠前的狼人", params.WolfMajority, four,
+			map[string]string{"Player1": "Player5", "Player2": "Player6", "Player3": "Player6", "Player4": "Player5"}, "Player5", locale.Prompts.ToWolvesMajority},
+		{"只统计提议过的狼人", params.WolfMajority, three,
+			map[string]string{"Player2": "Player7"}, "Player7", locale.Prompts.ToWolvesMajority},
+		{"狼首决定", params.WolfLeader, three,
+			map[string]string{"Player1": "Player5", "Player2": "Player6", "Player3": "Player6"}, "Player5", fmt.Sprintf(locale.Prompts.ToWolvesLeader, "Player1")},
+		{"狼首没提议时按多数", params.WolfLeader, three,
+			map[string]string{"Player2": "Player6", "Player3": "Player6"}, "Player6", locale.Prompts.ToWolvesMajority},
+		{"没有提议", params.WolfMajority, three, map[string]string{}, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := params.DefaultBoard
+			board.WolfDecision = tt.rule
+			m := newTestModerator(t, board, []game.Role{game.RoleVillager}, nil)
+			target, decision := m.resolveProposals(tt.wolves, tt.proposals)
+			if target != tt.want || decision != tt.decision {
+				t.Errorf("resolveProposals() = (%q, %q)，期望 (%q, %q)", target, decision, tt.want, tt.decision)
+			}
+		})
+	}
+}
+
+func TestResolveProposalsRandomPicksAProposal(t *testing.T) {
+	board := params.DefaultBoard
+	board.WolfDecision = params.WolfRandom
+	m := newTestModerator(t, board, []game.Role{game.RoleVillager}, nil)
+	proposals := map[string]string{"Player1": "Player5", "Player2": "Player6"}
+	for i := 0; i < 20; i++ {
+		target, decision := m.resolveProposals([]string{"Player1", "Player2"}, proposals)
+		if target != "Player5" && target != "Player6" {
+			t.Fatalf("随机规则选出了没有被提议的目标 %q", target)
+		}
+		if decision != m.locale.Prompts.ToWolvesRandom {
+			t.Fatalf("decision = %q", decision)
+		}
+	}
+}
+
+func TestProposalList(t *testing.T) {
+	wolves := []string{"Player1", "Player2", "Player3"}
+	got := proposalList(wolves, map[string]string{"Player3": "Player7", "Player1": "Player5"})
+	if want := "Player1→Player5, Player3→Player7"; got != want {
+		t.Errorf("proposalList() = %q，期望 %q", got, want)
+	}
+}
+
+func TestWerewolfActionNegotiation(t *testing.T) {
+	roles := []game.Role{game.RoleWerewolf, game.RoleWerewolf, game.RoleVillager, game.RoleVillager, game.RoleSeer}
+	tests := []struct {
+		name    string
+		board   params.BoardConfig
+		replies map[string][]string
+		killed  string
+	}{
+		{
+			name: "第二名狼人附议后达成一致",
+			replies: map[string][]string{
+				"Player1": {`{"target":"Player5","message":"刀预言家"}`},
+				"Player2": {`{"target":"Player5","message":"同意"}`},
+			},
+			killed: "Player5",
+		},
+		{
+			name: "提议同伴会被拒绝并重新询问",
+			replies: map[string][]string{
+				"Player1": {`{"target":"Player2"}`, `{"target":"Player4"}`},
+				"Player2": {`{"target":"Player4"}`},
+			},
+			killed: "Player4",
+		},
+		{
+			name:  "始终不一致时按多数规则取狼首的提议",
+			board: params.BoardConfig{WolfDiscussionRounds: 1},
+			replies: map[string][]string{
+				"Player1": {`{"target":"Player3"}`},
+				"Player2": {`{"target":"Player4"}`},
+			},
+			killed: "Player3",
+		},
+		{
+			name:  "允许空刀时一致空刀",
+			board: params.BoardConfig{EmptyKill: true},
+			replies: map[string][]string{
+				"Player1": {`{"target":"none"}`},
+				"Player2": {`{"target":"none"}`},
+			},
+			killed: "",
+		},
+		{
+			name:  "文本回复中的空刀",
+			board: params.BoardConfig{EmptyKill: true},
+			replies: map[string][]string{
+				"Player1": {"今晚空刀吧"},
+				"Player2": {"同意空刀"},
+			},
+			killed: "",
+		},
+		{
+			name:  "文本回复中明确的目标优先于空刀",
+			board: params.BoardConfig{EmptyKill: true},
+			replies: map[string][]string{
+				"Player1": {"不要空刀，刀 Player4"},
+				"Player2": {"空刀没意义，同意刀 Player4"},
+			},
+			killed: "Player4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := params.DefaultBoard
+			board.EmptyKill = tt.board.EmptyKill
+			if tt.board.WolfDiscussionRounds > 0 {
+				board.WolfDiscussionRounds = tt.board.WolfDiscussionRounds
+			}
+			m := newTestModerator(t, board, roles, tt.replies)
+			m.state.Round = 1
+			drive(func(gen *adkGen) { m.werewolfAction(context.Background(), gen) })
+			if got := m.state.GetNightKilled(); got != tt.killed {
+				t.Errorf("击杀目标 = %q，期望 %q", got, tt.killed)
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package analysis 根据一局游戏的结构化事件生成赛后分析
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// Vote 一张白天放逐票
+type Vote struct {
+	Voter  string `json:"voter"`
+	Target string `json:"target"`
+}
+
+// DayVotes 某一天的投票流向
+type DayVotes struct {
+	Round      int      `json:"round"`
+	Votes      []Vote   `json:"votes"`
+	Abstained  []string `json:"abstained,omitempty"` // 弃票的玩家
+	Spoiled    []string `json:"spoiled,omitempty"`   // 废票的玩家
+	Eliminated string   `json:"eliminated,omitempty"`
+}
+
+// Claim 玩家在发言中声明的身份
+type Claim struct {
+	Round      int       `json:"round"`
+	Role       game.Role `json:"role"`
+	False      bool      `json:"false"`      // 声明的身份与真实身份不符
+	Structured bool      `json:"structured"` // 来自 speech 工具，否则为从发言文本中识别
+}
+
+// ClaimedCheck 玩家公开声称的一次查验结果（来自 speech 工具）
+type ClaimedCheck struct {
+	Round  int    `json:"round"`
+	Player string `json:"player"`
+	Target string `json:"target"`
+	IsWolf bool   `json:"is_wolf"`
+	// 声称者不是预言家，或声称的结果与目标真实阵营不符
+	Fake bool `json:"fake"`
+}
+
+// CounterClaim 多名玩家声称同一神职
+type CounterClaim struct {
+	Role    game.Role `json:"role"`
+	Players []string  `json:"players"`
+}
+
+// SeerCheck 一次预言家查验及其是否被好人采纳
+type SeerCheck struct {
+	Round  int    `json:"round"`
+	Target string `json:"target"`
+	IsWolf bool   `json:"is_wolf"`
+	// 查验为狼人时：该狼人之后被放逐；查验为好人时：该玩家之后没有被放逐
+	ActedUpon bool `json:"acted_upon"`
+	// 查验之后好人投给该玩家的票数
+	VotesAfter int `json:"votes_after"`
+}
+
+// PlayerStats 单个玩家的赛后指标
+type PlayerStats struct {
+	Player string    `json:"player"`
+	Role   game.Role `json:"role"`
+
+	DeathRound int    `json:"death_round,omitempty"` // 0 表示存活到最后
+	DeathCause string `json:"death_cause,omitempty"`
+
+	Speeches      int `json:"speeches"`
+	VotesCast     int `json:"votes_cast"`
+	VotesReceived int `json:"votes_received"`
+
+	// 好人阵营：投给真狼的票数及命中率
+	VotesOnWolves int     `json:"votes_on_wolves"`
+	VoteAccuracy  float64 `json:"vote_accuracy"`
+
+	// 狼人：存活期间好人投给自己的票数，以及伪装分
+	// 伪装分 = 1 - 好人投给该狼的票数 / 该狼存活期间好人投出的总票数，越高说明隐藏越好
+	VillagerVotesDrawn int     `json:"villager_votes_drawn"`
+	DeceptionScore     float64 `json:"deception_score"`
+
+	Claims []Claim `json:"claims,omitempty"`
+
+	// belief 工具提交的概率判断的评分
+	Belief *game.Calibration `json:"belief,omitempty"`
+
+	// speech 工具中怀疑的玩家里真狼的比例（按每次声明的怀疑对象计）
+	Suspects        int     `json:"suspects"`
+	SuspectsCorrect int     `json:"suspects_correct"`
+	SuspectAccuracy float64 `json:"suspect_accuracy"`
+}
+
+// Analysis 一局游戏的赛后分析
+type Analysis struct {
+	GameID string       `json:"game_id,omitempty"`
+	Winner game.Faction `json:"winner,omitempty"`
+	Rounds int          `json:"rounds"`
+
+	Players []PlayerStats `json:"players"`
+	Days    []DayVotes    `json:"days"`
+	Checks  []SeerCheck   `json:"seer_checks"`
+
+	ClaimedChecks []ClaimedCheck `json:"claimed_checks,omitempty"`
+	CounterClaims []CounterClaim `json:"counter_claims,omitempty"`
+
+	// 好人阵营整体投票命中率
+	VillagerVoteAccuracy float64 `json:"villager_vote_accuracy"`
+
+	// 好人阵营概率判断的整体评分（狼人知道同伴身份，不计入）
+	Belief *game.Calibration `json:"belief,omitempty"`
+
+	locale *params.Locale // 报告使用的语言，与识别身份声明的语言一致
+}
+
+// Analyze 根据结构化事件计算每名玩家的指标和每天的投票流向
+// locale 用于识别发言中的身份声明和生成报告，为空时使用中文
+func Analyze(events []game.Event, locale *params.Locale) *Analysis {
+	if locale == nil {
+		locale = params.NewLocale("")
+	}
+
+	a := &Analysis{locale: locale}
+	roles := make(map[string]game.Role)
+	stats := make(map[string]*PlayerStats)
+	deathSeq := make(map[string]int) // 死亡事件序号
+	voteSeq := make(map[int]int)     // 每天放逐结果事件序号
+	var day *DayVotes
+	var checks []SeerCheck
+	var beliefs []game.Belief
+
+	for _, e := range events {
+		if e.Round > a.Rounds {
+			a.Rounds = e.Round
+		}
+
+		switch e.Type {
+		case game.EventGameStart:
+			for name, role := range e.Roles {
+				roles[name] = role
+				stats[name] = &PlayerStats{Player: name, Role: role}
+			}
+
+		case game.EventSpeech:
+			if p := stats[e.Actor]; p != nil {
+				p.Speeches++
+				for _, role := range detectClaims(e.Content, locale) {
+					p.addClaim(Claim{Round: e.Round, Role: role, False: role != p.Role})
+				}
+			}
+
+		case game.EventClaim:
+			p := stats[e.Actor]
+			if p == nil || e.Claim == nil {
+				continue
+			}
+			if e.Claim.Role != "" {
+				p.addClaim(Claim{Round: e.Round, Role: e.Claim.Role, False: e.Claim.Role != p.Role, Structured: true})
+			}
+			for _, c := range e.Claim.Checks {
+				actualWolf := roles[c.Target].SeenAs().IsWolf() // 真预言家看到的结果，隐狼显示为好人
+				a.ClaimedChecks = append(a.ClaimedChecks, ClaimedCheck{
+					Round:  e.Round,
+					Player: e.Actor,
+					Target: c.Target,
+					IsWolf: c.IsWolf,
+					Fake:   p.Role != game.RoleSeer || c.IsWolf != actualWolf,
+				})
+			}
+			for _, suspect := range e.Claim.Suspects {
+				p.Suspects++
+				if roles[suspect].IsWolf() {
+					p.SuspectsCorrect++
+				}
+			}
+
+		case game.EventVote, game.EventAbstain, game.EventSpoiled:
+			if day == nil || day.Round != e.Round {
+				a.Days = append(a.Days, DayVotes{Round: e.Round})
+				day = &a.Days[len(a.Days)-1]
+			}
+			switch e.Type {
+			case game.EventVote:
+				day.Votes = append(day.Votes, Vote{Voter: e.Actor, Target: e.Target})
+			case game.EventAbstain:
+				day.Abstained = append(day.Abstained, e.Actor)
+			default:
+				day.Spoiled = append(day.Spoiled, e.Actor)
+			}
+
+		case game.EventVoteResult:
+			if day != nil && day.Round == e.Round {
+				day.Eliminated = e.Target
+			}
+			voteSeq[e.Round] = e.Seq
+
+		case game.EventSeerCheck:
+			checks = append(checks, SeerCheck{Round: e.Round, Target: e.Target, IsWolf: roles[e.Target].SeenAs().IsWolf()})
+
+		case game.EventDeath:
+			if p := stats[e.Target]; p != nil && p.DeathRound == 0 {
+				p.DeathRound = e.Round
+				p.DeathCause = e.Content
+				deathSeq[e.Target] = e.Seq
+			}
+
+		case game.EventBelief:
+			beliefs = append(beliefs, game.Belief{Player: e.Actor, Round: e.Round, Phase: e.Content, Probs: e.Beliefs})
+
+		case game.EventGameOver:
+			a.Winner = e.Winner
+		}
+	}
+
+	// 投票指标，exposure 为每只狼存活期间好人投出的总票数
+	var villagerVotes, villagerHits int
+	exposure := make(map[string]int)
+	for _, d := range a.Days {
+		var villagerVotesToday int
+		for _, v := range d.Votes {
+			if !roles[v.Voter].IsWolf() {
+				villagerVotesToday++
+			}
+		}
+
+		for _, v := range d.Votes {
+			voter, target := stats[v.Voter], stats[v.Target]
+			if voter != nil {
+				voter.VotesCast++
+			}
+			if target != nil {
+				target.VotesReceived++
+			}
+			if voter == nil || voter.Role.IsWolf() {
+				continue
+			}
+			villagerVotes++
+			if roles[v.Target].IsWolf() {
+				voter.VotesOnWolves++
+				villagerHits++
+				target.VillagerVotesDrawn++
+			}
+		}
+
+		// 当天投票时仍存活的狼人计入好人总票数
+		for name, p := range stats {
+			if p.Role.IsWolf() && aliveAtVote(deathSeq, voteSeq, name, d.Round) {
+				exposure[name] += villagerVotesToday
+			}
+		}
+	}
+	a.VillagerVoteAccuracy = ratio(villagerHits, villagerVotes)
+
+	for _, p := range stats {
+		p.SuspectAccuracy = ratio(p.SuspectsCorrect, p.Suspects)
+		if !p.Role.IsWolf() {
+			p.VoteAccuracy = ratio(p.VotesOnWolves, p.VotesCast)
+			continue
+		}
+		p.DeceptionScore = 1 - ratio(p.VillagerVotesDrawn, exposure[p.Player])
+	}
+
+	// 概率判断评分
+	if len(beliefs) > 0 {
+		overall, perPlayer := game.ScoreBeliefs(beliefs, roles)
+		if overall.Samples > 0 {
+			a.Belief = overall
+		}
+		for name, c := range perPlayer {
+			if p := stats[name]; p != nil && c.Samples > 0 {
+				p.Belief = c
+			}
+		}
+	}
+
+	// 预言家查验是否被采纳
+	for i := range checks {
+		c := &checks[i]
+		votedOut := false
+		for _, d := range a.Days {
+			if d.Round < c.Round {
+				continue
+			}
+			if d.Eliminated == c.Target {
+				votedOut = true
+			}
+			for _, v := range d.Votes {
+				if v.Target == c.Target && !roles[v.Voter].IsWolf() {
+					c.VotesAfter++
+				}
+			}
+		}
+		c.ActedUpon = votedOut == c.IsWolf
+	}
+	a.Checks = checks
+
+	for _, p := range stats {
+		a.Players = append(a.Players, *p)
+	}
+	sort.Slice(a.Players, func(i, j int) bool {
+		return playerLess(a.Players[i].Player, a.Players[j].Player)
+	})
+
+	// 神职对跳
+	for _, role := range []game.Role{game.RoleSeer, game.RoleWitch, game.RoleHunter} {
+		var claimants []string
+		for _, p := range a.Players {
+			for _, c := range p.Claims {
+				if c.Role == role {
+					claimants = append(claimants, p.Player)
+					break
+				}
+			}
+		}
+		if len(claimants) > 1 {
+			a.CounterClaims = append(a.CounterClaims, CounterClaim{Role: role, Players: claimants})
+		}
+	}
+	return a
+}
+
+// addClaim 记录身份声明，同一回合对同一身份的重复声明只保留一条，结构化声明优先
+func (p *PlayerStats) addClaim(claim Claim) {
+	for i, c := range p.Claims {
+		if c.Round == claim.Round && c.Role == claim.Role {
+			p.Claims[i].Structured = c.Structured || claim.Structured
+			return
+		}
+	}
+	p.Claims = append(p.Claims, claim)
+}
+
+// aliveAtVote 玩家在当天投票时是否存活（死于放逐结果之后，包括当天被放逐）
+func aliveAtVote(deathSeq map[string]int, voteSeq map[int]int, name string, round int) bool {
+	seq, dead := deathSeq[name]
+	return !dead || seq >= voteSeq[round]
+}
+
+// detectClaims 识别发言中的身份声明
+func detectClaims(content string, locale *params.Locale) []game.Role {
+	lower := strings.ToLower(content)
+	var claims []game.Role
+	for _, role := range []game.Role{game.RoleSeer, game.RoleWitch, game.RoleHunter, game.RoleVillager, game.RoleWerewolf} {
+		name := strings.ToLower(locale.I18n.RoleNames[role])
+		if name == "" {
+			continue
+		}
+		for _, phrase := range locale.I18n.ClaimPhrases {
+			if strings.Contains(lower, strings.ToLower(fmt.Sprintf(phrase, name))) {
+				claims = append(claims, role)
+				break
+			}
+		}
+	}
+	return claims
+}
+
+// playerLess 按玩家编号排序（Player2 排在 Player10 之前）
+func playerLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
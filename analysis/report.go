@@ -0,0 +1,303 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// Markdown 生成 Markdown 格式的赛后分析，投票流向使用 Mermaid 图，文案使用分析时的语言
+func (a *Analysis) Markdown() string {
+	text, log := a.text()
+	var sb strings.Builder
+	sb.WriteString(text.AnalysisTitle + "\n\n")
+	if a.GameID != "" {
+		sb.WriteString(fmt.Sprintf(log.GameID+"\n\n", a.GameID))
+	}
+	winner := text.None
+	if a.Winner != "" {
+		winner = a.factionName(a.Winner)
+	}
+	sb.WriteString(fmt.Sprintf(text.AnalysisSummary+"\n\n", winner, a.Rounds, a.VillagerVoteAccuracy*100))
+
+	sb.WriteString(text.PlayerMetrics + "\n\n")
+	sb.WriteString(text.TableHeader(text.PlayerTableHeader))
+	for _, p := range a.Players {
+		death := "-"
+		if p.DeathRound > 0 {
+			death = fmt.Sprintf("R%d %s", p.DeathRound, a.deathCause(p.DeathCause))
+		}
+		accuracy, deception, suspects := "-", "-", "-"
+		if p.Suspects > 0 {
+			suspects = fmt.Sprintf("%d/%d", p.SuspectsCorrect, p.Suspects)
+		}
+		if p.Role.IsWolf() {
+			deception = fmt.Sprintf("%.2f", p.DeceptionScore)
+		} else if p.VotesCast > 0 {
+			accuracy = fmt.Sprintf("%.0f%%", p.VoteAccuracy*100)
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %s | %d | %d | %d | %d | %s | %s | %s | %s |\n",
+			p.Player, a.roleName(p.Role), death, p.Speeches, p.VotesCast, p.VotesReceived, p.VotesOnWolves,
+			accuracy, suspects, deception, a.formatClaims(p.Claims)))
+	}
+
+	sb.WriteString("\n" + text.SeerChecks + "\n\n")
+	if len(a.Checks) == 0 {
+		sb.WriteString(text.NoChecks + "\n")
+	} else {
+		sb.WriteString(text.TableHeader(text.ChecksTableHeader))
+		for _, c := range a.Checks {
+			sb.WriteString(fmt.Sprintf("| %d | %s | %s | %d | %s |\n", c.Round, c.Target, a.checkResult(c.IsWolf), c.VotesAfter, yesNo(c.ActedUpon)))
+		}
+	}
+
+	sb.WriteString("\n" + text.ClaimedChecks + "\n\n")
+	if len(a.ClaimedChecks) == 0 {
+		sb.WriteString(text.None + "\n")
+	} else {
+		sb.WriteString(text.TableHeader(text.ClaimedTableHeader))
+		for _, c := range a.ClaimedChecks {
+			fake := "-"
+			if c.Fake {
+				fake = text.FakeCheck
+			}
+			sb.WriteString(fmt.Sprintf("| %d | %s (%s) | %s | %s | %s |\n", c.Round, c.Player, a.roleName(a.role(c.Player)), c.Target, a.checkResult(c.IsWolf), fake))
+		}
+	}
+
+	if len(a.CounterClaims) > 0 {
+		sb.WriteString("\n" + text.CounterClaims + "\n\n")
+		for _, c := range a.CounterClaims {
+			var parts []string
+			for _, p := range c.Players {
+				parts = append(parts, fmt.Sprintf("%s (%s)", p, a.roleName(a.role(p))))
+			}
+			sb.WriteString(fmt.Sprintf("- %s: %s\n", a.roleName(c.Role), strings.Join(parts, ", ")))
+		}
+	}
+
+	var falseClaims []string
+	for _, p := range a.Players {
+		for _, c := range p.Claims {
+			if c.False {
+				falseClaims = append(falseClaims, fmt.Sprintf(text.FalseClaim, c.Round, p.Player, a.roleName(p.Role), a.roleName(c.Role)))
+			}
+		}
+	}
+	sb.WriteString("\n" + text.FalseClaims + "\n\n")
+	if len(falseClaims) == 0 {
+		sb.WriteString(text.None + "\n")
+	} else {
+		sb.WriteString(strings.Join(falseClaims, "\n") + "\n")
+	}
+
+	if a.Belief != nil {
+		sb.WriteString("\n" + text.Beliefs + "\n\n")
+		sb.WriteString(fmt.Sprintf(text.BeliefSummary+"\n\n", a.Belief.Samples, a.Belief.Brier, a.Belief.LogLoss))
+		sb.WriteString(text.TableHeader(text.BeliefTableHeader))
+		for _, p := range a.Players {
+			if p.Belief == nil {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("| %s | %s | %d | %.3f | %.3f |\n", p.Player, a.roleName(p.Role), p.Belief.Samples, p.Belief.Brier, p.Belief.LogLoss))
+		}
+	}
+
+	sb.WriteString("\n" + text.VoteFlow + "\n")
+	for _, d := range a.Days {
+		sb.WriteString(fmt.Sprintf("\n"+text.VoteFlowDay+"\n\n", d.Round))
+		sb.WriteString("```mermaid\n")
+		sb.WriteString(a.Mermaid(d))
+		sb.WriteString("```\n")
+	}
+
+	return sb.String()
+}
+
+// DOT 生成某一天投票流向的 Graphviz 图：狼人为红色，被放逐的玩家为双圈
+func (a *Analysis) DOT(d DayVotes) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("digraph day%d {\n", d.Round))
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString(fmt.Sprintf("  label=\"Day %d\";\n", d.Round))
+	for _, name := range dayPlayers(d) {
+		attrs := []string{fmt.Sprintf("label=\"%s\\n%s\"", name, a.role(name))}
+		if a.role(name).IsWolf() {
+			attrs = append(attrs, "color=red", "fontcolor=red")
+		}
+		if name == d.Eliminated {
+			attrs = append(attrs, "shape=doublecircle")
+		}
+		sb.WriteString(fmt.Sprintf("  %q [%s];\n", name, strings.Join(attrs, ", ")))
+	}
+	for _, v := range d.Votes {
+		sb.WriteString(fmt.Sprintf("  %q -> %q;\n", v.Voter, v.Target))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Mermaid 生成某一天投票流向的 Mermaid 图
+func (a *Analysis) Mermaid(d DayVotes) string {
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for _, name := range dayPlayers(d) {
+		label := fmt.Sprintf("%s<br/>%s", name, a.roleName(a.role(name)))
+		if name == d.Eliminated {
+			sb.WriteString(fmt.Sprintf("  %s(((\"%s\")))\n", name, label))
+		} else {
+			sb.WriteString(fmt.Sprintf("  %s[\"%s\"]\n", name, label))
+		}
+		if a.role(name).IsWolf() {
+			sb.WriteString(fmt.Sprintf("  style %s stroke:#d33,color:#d33\n", name))
+		}
+	}
+	for _, v := range d.Votes {
+		sb.WriteString(fmt.Sprintf("  %s --> %s\n", v.Voter, v.Target))
+	}
+	return sb.String()
+}
+
+// Save 将分析结果写入游戏日志目录：analysis.md、analysis.json 以及每天一个 votes_dayN.dot
+func (a *Analysis) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("创建分析目录失败: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "analysis.md"), []byte(a.Markdown()), 0644); err != nil {
+		return fmt.Errorf("保存赛后分析失败: %w", err)
+	}
+
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化赛后分析失败: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "analysis.json"), data, 0644); err != nil {
+		return fmt.Errorf("保存赛后分析失败: %w", err)
+	}
+
+	for _, d := range a.Days {
+		path := filepath.Join(dir, fmt.Sprintf("votes_day%d.dot", d.Round))
+		if err := os.WriteFile(path, []byte(a.DOT(d)), 0644); err != nil {
+			return fmt.Errorf("保存投票流向图失败: %w", err)
+		}
+	}
+	return nil
+}
+
+// text 返回分析报告和日志的文案，没有语言包时使用中文
+func (a *Analysis) text() (*params.ReportText, *game.LogText) {
+	locale := a.locale
+	if locale == nil {
+		locale = params.NewLocale("")
+	}
+	return &locale.I18n.Report, &locale.I18n.Log
+}
+
+// roleName 返回角色的本地化名称
+func (a *Analysis) roleName(role game.Role) string {
+	_, log := a.text()
+	if name, ok := log.RoleNames[role]; ok {
+		return name
+	}
+	return string(role)
+}
+
+// factionName 返回阵营的本地化名称
+func (a *Analysis) factionName(faction game.Faction) string {
+	_, log := a.text()
+	return log.CheckResultName(game.CheckResult{Faction: faction})
+}
+
+// deathCause 返回死因的本地化名称
+func (a *Analysis) deathCause(cause string) string {
+	_, log := a.text()
+	if name, ok := log.DeathCauses[cause]; ok {
+		return name
+	}
+	return cause
+}
+
+// checkResult 返回查验结果（阵营）的本地化名称
+func (a *Analysis) checkResult(isWolf bool) string {
+	if isWolf {
+		return a.factionName(game.FactionWerewolf)
+	}
+	return a.factionName(game.FactionVillager)
+}
+
+func (a *Analysis) role(name string) game.Role {
+	for _, p := range a.Players {
+		if p.Player == name {
+			return p.Role
+		}
+	}
+	return ""
+}
+
+// dayPlayers 当天参与投票（投票或被投）的玩家
+func dayPlayers(d DayVotes) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, v := range d.Votes {
+		for _, name := range []string{v.Voter, v.Target} {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	if d.Eliminated != "" && !seen[d.Eliminated] {
+		names = append(names, d.Eliminated)
+	}
+	return names
+}
+
+// formatClaims 按首次出现的顺序列出声明过的身份，虚假声明带 ❌
+func (a *Analysis) formatClaims(claims []Claim) string {
+	if len(claims) == 0 {
+		return "-"
+	}
+	parts := make([]string, 0, len(claims))
+	seen := make(map[game.Role]bool)
+	for _, c := range claims {
+		if seen[c.Role] {
+			continue
+		}
+		seen[c.Role] = true
+		part := a.roleName(c.Role)
+		if c.False {
+			part += " ❌"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "✅"
+	}
+	return "❌"
+}
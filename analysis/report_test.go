@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analysis
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// sampleEvents 一局四人游戏：第 1 天放逐狼人 Player1，好人获胜
+func sampleEvents() []game.Event {
+	roles := map[string]game.Role{
+		"Player1": game.RoleWerewolf, "Player2": game.RoleSeer, "Player3": game.RoleVillager, "Player4": game.RoleWitch,
+	}
+	return []game.Event{
+		{Seq: 1, Type: game.EventGameStart, Roles: roles},
+		{Seq: 2, Round: 1, Type: game.EventSeerCheck, Actor: "Player2", Target: "Player1", Faction: game.FactionWerewolf},
+		{Seq: 3, Round: 1, Type: game.EventDeath, Target: "Player4", Content: game.DeathKilled},
+		{Seq: 4, Round: 1, Type: game.EventSpeech, Actor: "Player1"},
+		{Seq: 5, Round: 1, Type: game.EventClaim, Actor: "Player1", Claim: &game.SpeechClaim{
+			Role: game.RoleSeer, Checks: []game.ClaimedCheck{{Target: "Player2", IsWolf: true}},
+		}},
+		{Seq: 6, Round: 1, Type: game.EventClaim, Actor: "Player2", Claim: &game.SpeechClaim{Role: game.RoleSeer}},
+		{Seq: 7, Round: 1, Type: game.EventVote, Actor: "Player1", Target: "Player2"},
+		{Seq: 8, Round: 1, Type: game.EventVote, Actor: "Player2", Target: "Player1"},
+		{Seq: 9, Round: 1, Type: game.EventVote, Actor: "Player3", Target: "Player1"},
+		{Seq: 10, Round: 1, Type: game.EventVoteResult, Target: "Player1"},
+		{Seq: 11, Round: 1, Type: game.EventDeath, Target: "Player1", Content: game.DeathVoted},
+		{Seq: 12, Round: 1, Type: game.EventBelief, Actor: "Player3", Beliefs: map[string]float64{"Player1": 0.9, "Player2": 0.1}},
+		{Seq: 13, Round: 1, Type: game.EventGameOver, Winner: game.FactionVillager},
+	}
+}
+
+func TestMarkdownUsesLocale(t *testing.T) {
+	for _, lang := range []string{"zh", "en", "ja"} {
+		t.Run(lang, func(t *testing.T) {
+			locale := params.NewLocale(lang)
+			text, log := &locale.I18n.Report, &locale.I18n.Log
+			md := Analyze(sampleEvents(), locale).Markdown()
+
+			for _, want := range []string{
+				text.AnalysisTitle,
+				text.PlayerMetrics,
+				text.SeerChecks,
+				text.ClaimedChecks,
+				text.CounterClaims,
+				text.FalseClaims,
+				text.Beliefs,
+				text.VoteFlow,
+				log.RoleNames[game.RoleWerewolf],
+				log.FactionNames[game.FactionVillager],
+				log.DeathCauses[game.DeathVoted],
+				text.FakeCheck,
+			} {
+				if !strings.Contains(md, want) {
+					t.Errorf("报告中缺少 %q", want)
+				}
+			}
+		})
+	}
+}
+
+func TestEnglishMarkdownHasNoChinese(t *testing.T) {
+	md := Analyze(sampleEvents(), params.NewLocale("en")).Markdown()
+	for _, line := range strings.Split(md, "\n") {
+		if strings.IndexFunc(line, func(r rune) bool { return unicode.Is(unicode.Han, r) }) >= 0 {
+			t.Errorf("英文报告中有中文: %q", line)
+		}
+	}
+}
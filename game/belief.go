@@ -0,0 +1,183 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package game
+
+import "math"
+
+// Belief 玩家在某一时刻对其他玩家是狼人的概率判断
+type Belief struct {
+	Player string             `json:"player"`
+	Round  int                `json:"round"`
+	Phase  string             `json:"phase"`
+	Probs  map[string]float64 `json:"probs"` // 玩家名 -> 是狼人的概率
+}
+
+// RecordBelief 记录玩家提交的概率判断，等待主持人写入事件日志
+func (gs *GameState) RecordBelief(player string, probs map[string]float64) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	b := Belief{Player: player, Round: gs.Round, Phase: gs.Phase, Probs: probs}
+	gs.pendingBeliefs = append(gs.pendingBeliefs, b)
+	gs.beliefs = append(gs.beliefs, b)
+}
+
+// TakeBeliefs 取出玩家尚未写入日志的概率判断
+func (gs *GameState) TakeBeliefs(player string) []Belief {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	var taken, rest []Belief
+	for _, b := range gs.pendingBeliefs {
+		if b.Player == player {
+			taken = append(taken, b)
+		} else {
+			rest = append(rest, b)
+		}
+	}
+	gs.pendingBeliefs = rest
+	return taken
+}
+
+// Beliefs 返回本局所有的概率判断
+func (gs *GameState) Beliefs() []Belief {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+	beliefs := make([]Belief, len(gs.beliefs))
+	copy(beliefs, gs.beliefs)
+	return beliefs
+}
+
+// calibrationBins 校准曲线的分桶数
+const calibrationBins = 10
+
+// logLossEpsilon 计算对数损失时将概率裁剪到 [eps, 1-eps]，避免 0 和 1 导致无穷大
+const logLossEpsilon = 1e-3
+
+// CalibrationBin 校准曲线中的一个概率区间
+type CalibrationBin struct {
+	Low           float64 `json:"low"`
+	High          float64 `json:"high"`
+	Count         int     `json:"count"`
+	MeanPredicted float64 `json:"mean_predicted"`
+	Observed      float64 `json:"observed"` // 区间内目标真实为狼人的比例
+
+	sumPredicted float64
+	wolves       int
+}
+
+// Calibration 概率判断相对真实身份的评分（Brier 分数、对数损失和校准曲线）
+// 可以逐条累加，也可以合并多局的结果
+type Calibration struct {
+	Samples int              `json:"samples"`
+	Brier   float64          `json:"brier"`    // 越低越好，始终猜 0.5 为 0.25
+	LogLoss float64          `json:"log_loss"` // 越低越好，始终猜 0.5 约为 0.693
+	Bins    []CalibrationBin `json:"bins"`
+
+	sumBrier   float64
+	sumLogLoss float64
+}
+
+// NewCalibration 创建空的评分累加器
+func NewCalibration() *Calibration {
+	c := &Calibration{Bins: make([]CalibrationBin, calibrationBins)}
+	for i := range c.Bins {
+		c.Bins[i].Low = float64(i) / calibrationBins
+		c.Bins[i].High = float64(i+1) / calibrationBins
+	}
+	return c
+}
+
+// Add 累加一条预测：p 为预测是狼人的概率，isWolf 为真实身份
+func (c *Calibration) Add(p float64, isWolf bool) {
+	p = math.Max(0, math.Min(1, p))
+	y := 0.0
+	if isWolf {
+		y = 1
+	}
+
+	clipped := math.Max(logLossEpsilon, math.Min(1-logLossEpsilon, p))
+	c.Samples++
+	c.sumBrier += (p - y) * (p - y)
+	c.sumLogLoss += -(y*math.Log(clipped) + (1-y)*math.Log(1-clipped))
+
+	idx := min(int(p*calibrationBins), calibrationBins-1)
+	bin := &c.Bins[idx]
+	bin.Count++
+	bin.sumPredicted += p
+	if isWolf {
+		bin.wolves++
+	}
+	c.update()
+}
+
+// Merge 合并另一组评分
+func (c *Calibration) Merge(other *Calibration) {
+	if other == nil {
+		return
+	}
+	c.Samples += other.Samples
+	c.sumBrier += other.sumBrier
+	c.sumLogLoss += other.sumLogLoss
+	for i := range c.Bins {
+		c.Bins[i].Count += other.Bins[i].Count
+		c.Bins[i].sumPredicted += other.Bins[i].sumPredicted
+		c.Bins[i].wolves += other.Bins[i].wolves
+	}
+	c.update()
+}
+
+// update 根据累加值刷新导出的统计量
+func (c *Calibration) update() {
+	if c.Samples == 0 {
+		return
+	}
+	c.Brier = c.sumBrier / float64(c.Samples)
+	c.LogLoss = c.sumLogLoss / float64(c.Samples)
+	for i := range c.Bins {
+		bin := &c.Bins[i]
+		if bin.Count > 0 {
+			bin.MeanPredicted = bin.sumPredicted / float64(bin.Count)
+			bin.Observed = float64(bin.wolves) / float64(bin.Count)
+		}
+	}
+}
+
+// ScoreBeliefs 按真实身份为概率判断打分
+// 返回好人阵营的整体评分（狼人知道同伴身份，不计入）以及每名玩家各自的评分
+// 玩家对自己的判断不计分
+func ScoreBeliefs(beliefs []Belief, roles map[string]Role) (*Calibration, map[string]*Calibration) {
+	overall := NewCalibration()
+	perPlayer := make(map[string]*Calibration)
+	for _, b := range beliefs {
+		pc, ok := perPlayer[b.Player]
+		if !ok {
+			pc = NewCalibration()
+			perPlayer[b.Player] = pc
+		}
+		for target, p := range b.Probs {
+			role, ok := roles[target]
+			if !ok || target == b.Player {
+				continue
+			}
+			isWolf := role.IsWolf()
+			pc.Add(p, isWolf)
+			if !roles[b.Player].IsWolf() {
+				overall.Add(p, isWolf)
+			}
+		}
+	}
+	return overall, perPlayer
+}
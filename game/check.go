@@ -0,0 +1,53 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package game
+
+// CheckMode 预言家查验结果的粒度
+type CheckMode string
+
+const (
+	CheckFaction CheckMode = "faction" // 只告知目标属于好人还是狼人阵营（默认）
+	CheckRole    CheckMode = "role"    // 告知目标的具体身份
+)
+
+// CheckResult 一次查验的结果，记录的是目标在预言家眼中表现出的身份，隐狼表现为村民
+type CheckResult struct {
+	Target  string
+	Faction Faction // 表现出的阵营
+	Role    Role    // 表现出的身份，仅 CheckRole 模式下填写
+}
+
+// IsWolf 判断查验结果是否为狼人
+func (r CheckResult) IsWolf() bool {
+	return r.Faction == FactionWerewolf
+}
+
+// CheckIdentity 按板子的查验粒度返回预言家查验 target 的结果
+func (gs *GameState) CheckIdentity(target string) CheckResult {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	seen := RoleVillager
+	if player, ok := gs.Players[target]; ok {
+		seen = player.Role.SeenAs()
+	}
+	result := CheckResult{Target: target, Faction: seen.Faction()}
+	if gs.rules.SeerCheck == CheckRole {
+		result.Role = seen
+	}
+	return result
+}
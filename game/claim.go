@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package game
+
+// ClaimedCheck 发言中声称的一次查验结果
+type ClaimedCheck struct {
+	Target string `json:"target"`
+	IsWolf bool   `json:"is_wolf"`
+}
+
+// SpeechClaim 玩家通过 speech 工具提交的结构化发言内容
+// 与自由文本发言并存，所有字段均可为空
+type SpeechClaim struct {
+	Role     Role           `json:"role,omitempty"`     // 声称的身份
+	Checks   []ClaimedCheck `json:"checks,omitempty"`   // 声称的查验结果（通常来自自称预言家的玩家）
+	Suspects []string       `json:"suspects,omitempty"` // 怀疑的玩家
+	Trusted  []string       `json:"trusted,omitempty"`  // 信任的玩家
+}
+
+// PublicClaim 一条公开的结构化发言记录
+type PublicClaim struct {
+	Player string      `json:"player"`
+	Round  int         `json:"round"`
+	Claim  SpeechClaim `json:"claim"`
+}
+
+// SetPendingClaim 暂存玩家本次发言提交的结构化内容，同一次发言内多次调用以最后一次为准
+func (gs *GameState) SetPendingClaim(player string, claim SpeechClaim) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	if gs.pendingClaims == nil {
+		gs.pendingClaims = make(map[string]SpeechClaim)
+	}
+	gs.pendingClaims[player] = claim
+}
+
+// PublishClaim 取出玩家暂存的结构化发言并计入公开记录，玩家本次未调用 speech 工具时返回 false
+// 只有真正公开的发言（白天发言、反驳、遗言）才应调用，避免私下回复中的内容泄露
+func (gs *GameState) PublishClaim(player string) (SpeechClaim, bool) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	claim, ok := gs.pendingClaims[player]
+	if !ok {
+		return SpeechClaim{}, false
+	}
+	delete(gs.pendingClaims, player)
+	gs.claims = append(gs.claims, PublicClaim{Player: player, Round: gs.Round, Claim: claim})
+	return claim, true
+}
+
+// DiscardClaim 丢弃玩家暂存的结构化发言（非公开场合调用了 speech 工具）
+func (gs *GameState) DiscardClaim(player string) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	delete(gs.pendingClaims, player)
+}
+
+// PublicClaims 返回目前为止所有公开的结构化发言
+func (gs *GameState) PublicClaims() []PublicClaim {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+	claims := make([]PublicClaim, len(gs.claims))
+	copy(claims, gs.claims)
+	return claims
+}
+
+// RoleClaimants 按声称的身份汇总玩家（按首次声明顺序），同一身份有多人声明即为对跳
+func (gs *GameState) RoleClaimants() map[Role][]string {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+	claimants := make(map[Role][]string)
+	seen := make(map[string]bool)
+	for _, c := range gs.claims {
+		if c.Claim.Role == "" {
+			continue
+		}
+		key := string(c.Claim.Role) + "/" + c.Player
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		claimants[c.Claim.Role] = append(claimants[c.Claim.Role], c.Player)
+	}
+	return claimants
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package game
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// EventType 结构化事件类型
+type EventType string
+
+const (
+	EventGameStart    EventType = "game_start"    // 角色分配，Roles 有值
+	EventRound        EventType = "round"         // 回合开始
+	EventPhase        EventType = "phase"         // 阶段开始，Content 为阶段名称
+	EventWolfSpeech   EventType = "wolf_speech"   // 狼人夜间讨论，Target 为本次发言提议的击杀目标
+	EventWolfVote     EventType = "wolf_vote"     // 单个狼人协商结束时的最终提议
+	EventWolfKill     EventType = "wolf_kill"     // 狼人击杀目标，Content 为各狼人的提议和决定方式；空刀时 Target 为空
+	EventSeerCheck    EventType = "seer_check"    // 预言家查验，Faction 为查验显示的阵营，按身份查验时 Content 为显示的身份
+	EventWitchSave    EventType = "witch_save"    // 女巫救人
+	EventWitchPoison  EventType = "witch_poison"  // 女巫毒人
+	EventDeath        EventType = "death"         // 玩家死亡，Content 为死因
+	EventSpeech       EventType = "speech"        // 白天发言
+	EventClaim        EventType = "claim"         // 发言附带的结构化内容，Claim 有值
+	EventBelief       EventType = "belief"        // 玩家对其他玩家是狼人的概率判断，Beliefs 有值，Content 为阶段
+	EventVote         EventType = "vote"          // 白天投票
+	EventAbstain      EventType = "abstain"       // 白天投票时主动弃票
+	EventSpoiled      EventType = "spoiled"       // 白天投票的废票，Target 为玩家最后选择的目标，Content 为原因
+	EventVoteResult   EventType = "vote_result"   // 放逐结果，Target 为空表示无人出局
+	EventLastWords    EventType = "last_words"    // 遗言
+	EventHunterShoot  EventType = "hunter_shoot"  // 猎人开枪
+	EventForfeit      EventType = "forfeit"       // 多次选择不合法目标而作废的行动，Action 为行动类型，Target 为最后选择的目标，Content 为原因
+	EventGameOver     EventType = "game_over"     // 游戏结束，Winner 有值
+	EventReflection   EventType = "reflection"    // 赛后反思
+	EventCommentary   EventType = "commentary"    // 面向观众的解说，Content 为解说内容，不进入玩家视角
+	EventModeratorMsg EventType = "moderator_msg" // 主持人消息
+)
+
+// 死因
+const (
+	DeathKilled   = "killed"
+	DeathPoisoned = "poisoned"
+	DeathShot     = "shot"
+	DeathVoted    = "voted"
+)
+
+// SpoiledNoResponse 玩家没有给出有效回复的废票原因，其余废票原因为目标不合法的 Violation
+const SpoiledNoResponse = "no_response"
+
+// Event 结构化游戏事件，按发生顺序写入 events.jsonl，供赛后分析和回放使用
+type Event struct {
+	Seq     int                `json:"seq"`
+	Time    time.Time          `json:"time"`
+	Type    EventType          `json:"type"`
+	Round   int                `json:"round"`
+	Actor   string             `json:"actor,omitempty"`
+	Action  Action             `json:"action,omitempty"`
+	Target  string             `json:"target,omitempty"`
+	Content string             `json:"content,omitempty"`
+	Roles   map[string]Role    `json:"roles,omitempty"`
+	Winner  Faction            `json:"winner,omitempty"`
+	Faction Faction            `json:"faction,omitempty"`
+	Claim   *SpeechClaim       `json:"claim,omitempty"`
+	Beliefs map[string]float64 `json:"beliefs,omitempty"`
+}
+
+// LoadEvents 从 events.jsonl 读取事件
+func LoadEvents(path string) ([]Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("打开事件日志失败: %w", err)
+	}
+	defer f.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("解析事件日志第 %d 行失败: %w", line, err)
+		}
+		events = append(events, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("读取事件日志失败: %w", err)
+	}
+	return events, nil
+}
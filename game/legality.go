@@ -0,0 +1,156 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package game
+
+import "fmt"
+
+// Action 需要选择目标的行动
+type Action string
+
+const (
+	ActionVote     Action = "vote"      // 白天投票
+	ActionWolfVote Action = "wolf_vote" // 狼人夜间投票击杀
+	ActionCheck    Action = "check"     // 预言家查验
+	ActionSave     Action = "save"      // 女巫使用解药
+	ActionPoison   Action = "poison"    // 女巫使用毒药
+	ActionShoot    Action = "shoot"     // 猎人开枪
+	ActionSheriff  Action = "sheriff"   // 警长竞选投票和移交警徽
+)
+
+// NoKill 狼人提议空刀（今晚不击杀任何人）时使用的目标
+const NoKill = "none"
+
+// Rules 影响行动合法性和结算的板子规则
+type Rules struct {
+	EmptyKill bool      // 狼人可以空刀
+	SelfKnife bool      // 狼人可以击杀同伴（自刀）
+	SeerCheck CheckMode // 预言家查验结果的粒度，为空时使用 CheckFaction
+}
+
+// Violation 目标不合法的原因
+type Violation string
+
+const (
+	ViolationMissing  Violation = "missing"   // 没有给出目标
+	ViolationUnknown  Violation = "unknown"   // 不在本局游戏中
+	ViolationDead     Violation = "dead"      // 已经出局
+	ViolationSelf     Violation = "self"      // 不能以自己为目标
+	ViolationTeammate Violation = "teammate"  // 狼人不能击杀同伴
+	ViolationNoKill   Violation = "no_kill"   // 本局不允许空刀
+	ViolationNoPotion Violation = "no_potion" // 药水已经用完
+)
+
+// TargetError 行动目标不合法，Reason 为原因代码，告诉玩家的本地化说明由调用方根据语言包生成
+type TargetError struct {
+	Action Action
+	Actor  string
+	Target string
+	Reason Violation
+}
+
+func (e *TargetError) Error() string {
+	return fmt.Sprintf("%s 的目标 %q 不合法: %s", e.Action, e.Target, e.Reason)
+}
+
+// SetRules 设置影响行动合法性和结算的板子规则
+func (gs *GameState) SetRules(rules Rules) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	gs.rules = rules
+}
+
+// Rules 返回影响行动合法性和结算的板子规则
+func (gs *GameState) Rules() Rules {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+	return gs.rules
+}
+
+// CheckTarget 校验 actor 执行 action 时选择的目标是否合法，不合法时返回 *TargetError
+//   - 所有行动：目标必须在本局游戏中且存活
+//   - 投票、查验、救人、毒人、开枪、警长投票和移交警徽：不能以自己为目标
+//   - 狼人击杀：不能选择狼人，Rules.SelfKnife 时可以；Rules.EmptyKill 时可以选择 NoKill 空刀
+//   - 救人、毒人：对应的药水必须可用
+func (gs *GameState) CheckTarget(action Action, actor, target string) error {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	reason := gs.violation(action, actor, target)
+	if reason == "" {
+		return nil
+	}
+	return &TargetError{Action: action, Actor: actor, Target: target, Reason: reason}
+}
+
+// LegalTargets 按座位顺序返回 actor 执行 action 时可以选择的目标，允许空刀时狼人击杀的最后一项为 NoKill
+func (gs *GameState) LegalTargets(action Action, actor string) []string {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	var targets []string
+	for _, name := range gs.Seats {
+		if gs.violation(action, actor, name) == "" {
+			targets = append(targets, name)
+		}
+	}
+	if action == ActionWolfVote && gs.rules.EmptyKill {
+		targets = append(targets, NoKill)
+	}
+	return targets
+}
+
+// violation 返回目标不合法的原因，合法时返回空字符串，调用方需持有读锁
+func (gs *GameState) violation(action Action, actor, target string) Violation {
+	switch action {
+	case ActionSave:
+		if !gs.HealingPotion {
+			return ViolationNoPotion
+		}
+	case ActionPoison:
+		if !gs.PoisonPotion {
+			return ViolationNoPotion
+		}
+	}
+
+	if target == "" {
+		return ViolationMissing
+	}
+	if action == ActionWolfVote && target == NoKill {
+		if gs.rules.EmptyKill {
+			return ""
+		}
+		return ViolationNoKill
+	}
+	player, ok := gs.Players[target]
+	if !ok {
+		return ViolationUnknown
+	}
+	if !player.Alive {
+		return ViolationDead
+	}
+
+	if action == ActionWolfVote {
+		if player.Role.IsWolf() && !gs.rules.SelfKnife {
+			return ViolationTeammate
+		}
+		return ""
+	}
+	if target == actor {
+		return ViolationSelf
+	}
+	return ""
+}
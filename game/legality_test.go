@@ -0,0 +1,115 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package game
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// newLegalityState 六人局：Player1、Player2 为狼人（Player2 是隐狼），Player3 预言家，Player4 女巫，Player5 猎人，Player6 村民（已出局）
+func newLegalityState(rules Rules) *GameState {
+	gs := NewGameState()
+	gs.InitPlayers(
+		[]string{"Player1", "Player2", "Player3", "Player4", "Player5", "Player6"},
+		[]Role{RoleWerewolf, RoleHiddenWolf, RoleSeer, RoleWitch, RoleHunter, RoleVillager},
+	)
+	gs.SetRules(rules)
+	gs.KillPlayer("Player6")
+	return gs
+}
+
+func TestCheckTarget(t *testing.T) {
+	tests := []struct {
+		name   string
+		rules  Rules
+		setup  func(gs *GameState)
+		action Action
+		actor  string
+		target string
+		want   Violation
+	}{
+		{"投票给存活玩家", Rules{}, nil, ActionVote, "Player3", "Player1", ""},
+		{"投票没有目标", Rules{}, nil, ActionVote, "Player3", "", ViolationMissing},
+		{"投票给不存在的玩家", Rules{}, nil, ActionVote, "Player3", "Player9", ViolationUnknown},
+		{"投票给出局玩家", Rules{}, nil, ActionVote, "Player3", "Player6", ViolationDead},
+		{"投票给自己", Rules{}, nil, ActionVote, "Player3", "Player3", ViolationSelf},
+		{"查验自己", Rules{}, nil, ActionCheck, "Player3", "Player3", ViolationSelf},
+		{"开枪带走狼人", Rules{}, nil, ActionShoot, "Player5", "Player1", ""},
+		{"警长投票给自己", Rules{}, nil, ActionSheriff, "Player4", "Player4", ViolationSelf},
+		{"狼人击杀好人", Rules{}, nil, ActionWolfVote, "Player1", "Player3", ""},
+		{"狼人击杀同伴", Rules{}, nil, ActionWolfVote, "Player1", "Player2", ViolationTeammate},
+		{"允许自刀时击杀同伴", Rules{SelfKnife: true}, nil, ActionWolfVote, "Player1", "Player2", ""},
+		{"允许自刀时击杀自己", Rules{SelfKnife: true}, nil, ActionWolfVote, "Player1", "Player1", ""},
+		{"不允许空刀", Rules{}, nil, ActionWolfVote, "Player1", NoKill, ViolationNoKill},
+		{"允许空刀", Rules{EmptyKill: true}, nil, ActionWolfVote, "Player1", NoKill, ""},
+		{"空刀只用于狼人击杀", Rules{EmptyKill: true}, nil, ActionVote, "Player3", NoKill, ViolationUnknown},
+		{"使用解药", Rules{}, nil, ActionSave, "Player4", "Player3", ""},
+		{"女巫自救", Rules{}, nil, ActionSave, "Player4", "Player4", ViolationSelf},
+		{"解药已用完", Rules{}, func(gs *GameState) { gs.SetNightSaved(true) }, ActionSave, "Player4", "Player3", ViolationNoPotion},
+		{"毒药已用完时先报药水", Rules{}, func(gs *GameState) { gs.SetNightPoisoned("Player1") }, ActionPoison, "Player4", "", ViolationNoPotion},
+		{"毒药没有目标", Rules{}, nil, ActionPoison, "Player4", "", ViolationMissing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := newLegalityState(tt.rules)
+			if tt.setup != nil {
+				tt.setup(gs)
+			}
+
+			err := gs.CheckTarget(tt.action, tt.actor, tt.target)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("CheckTarget() = %v，期望合法", err)
+				}
+				return
+			}
+			var illegal *TargetError
+			if !errors.As(err, &illegal) {
+				t.Fatalf("CheckTarget() = %v，期望 *TargetError", err)
+			}
+			want := TargetError{Action: tt.action, Actor: tt.actor, Target: tt.target, Reason: tt.want}
+			if *illegal != want {
+				t.Errorf("CheckTarget() = %+v，期望 %+v", *illegal, want)
+			}
+		})
+	}
+}
+
+func TestLegalTargets(t *testing.T) {
+	tests := []struct {
+		name   string
+		rules  Rules
+		action Action
+		actor  string
+		want   []string
+	}{
+		{"投票", Rules{}, ActionVote, "Player3", []string{"Player1", "Player2", "Player4", "Player5"}},
+		{"狼人击杀", Rules{}, ActionWolfVote, "Player1", []string{"Player3", "Player4", "Player5"}},
+		{"允许自刀", Rules{SelfKnife: true}, ActionWolfVote, "Player1", []string{"Player1", "Player2", "Player3", "Player4", "Player5"}},
+		{"允许空刀", Rules{EmptyKill: true}, ActionWolfVote, "Player1", []string{"Player3", "Player4", "Player5", NoKill}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := newLegalityState(tt.rules)
+			if got := gs.LegalTargets(tt.action, tt.actor); !slices.Equal(got, tt.want) {
+				t.Errorf("LegalTargets() = %v，期望 %v", got, tt.want)
+			}
+		})
+	}
+}
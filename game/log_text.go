@@ -0,0 +1,145 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package game
+
+// LogText 游戏日志文案，各语言的取值定义在 params 包中
+type LogText struct {
+	// 角色与阵营名称
+	RoleNames    map[Role]string
+	FactionNames map[Faction]string
+
+	// 日志头
+	FullTitle            string
+	ReplayTitle          string
+	GameID               string
+	StartTime            string
+	RoleAssignment       string
+	RoleTableHeader      string
+	ReplayRoleAssignment string
+
+	// 回合与主持人
+	Round       string
+	ReplayRound string
+	Moderator   string
+
+	// 狼人
+	WolfConspiracy       string
+	ReplayWolfConspiracy string
+	WolfIndividualVote   string
+	WolfKill             string
+	ReplayWolfKill       string
+	WolfNoKill           string
+	ReplayWolfNoKill     string
+
+	// 预言家与女巫
+	SeerCheck         string
+	ReplaySeerCheck   string
+	WitchSave         string
+	ReplayWitchSave   string
+	WitchPoison       string
+	ReplayWitchPoison string
+
+	// 夜晚结算
+	NightSummary     string
+	NightKilledSaved string
+	NightKilled      string
+	NightPoisoned    string
+	NightShot        string
+
+	// 白天
+	VoteResult      string
+	VoteResultNone  string
+	VoteAbstain     string
+	VoteSpoiled     string // 投票人、原因
+	ReplayVoteOut   string
+	LastWords       string
+	ReplayLastWords string
+
+	// 猎人
+	HunterShoot       string
+	ReplayHunterShoot string
+
+	// 游戏结束
+	GameOver        string
+	Winner          string
+	Survivors       string
+	Duration        string
+	ReplayWinner    string
+	ReplaySurvivors string
+
+	// 反思：LLM 可能添加的前缀
+	ReflectionPrefixes []string
+
+	// 解说记录（commentary.md）
+	CommentaryTitle string
+	CommentaryPhase string
+
+	// 终端回放（replay 命令）
+	ReplaySetup        string
+	ReplayStep         string // 回合与阶段标题
+	ReplayGodView      string
+	ReplaySeatView     string // 座位视角标题：座位、身份
+	ReplayModerator    string
+	ReplaySpeech       string
+	ReplayWolfSpeech   string
+	ReplayWolfProposal string // 狼人、提议目标、理由
+	ReplayWolfVote     string
+	ReplayVote         string
+	ReplayAbstain      string
+	ReplaySpoiled      string // 投票人、原因
+	ReplayVoteResult   string
+	ReplayDeath        string // 出局玩家、死因
+	ReplayDeathUnknown string // 该视角不知道死因
+	ReplayBelief       string
+	ReplayReflection   string
+	ReplayCommentary   string
+	ReplayHelp         string
+	DeathCauses        map[string]string // DeathKilled 等死因的名称
+	SpoiledReasons     map[string]string // 废票原因（Violation 和 SpoiledNoResponse）的名称
+
+	// 座位视角对话记录（seats/<座位>.md）
+	TranscriptTitle      string // 座位、身份
+	TranscriptSystem     string
+	TranscriptUser       string
+	TranscriptAssistant  string // 座位
+	TranscriptToolCall   string // 工具名、参数
+	TranscriptToolResult string // 工具名
+
+	Saved string
+}
+
+// SpoiledReason 返回废票原因的名称，没有对应名称时返回原因本身
+func (t *LogText) SpoiledReason(reason string) string {
+	if name, ok := t.SpoiledReasons[reason]; ok {
+		return name
+	}
+	return reason
+}
+
+// CheckResultName 返回查验结果的本地化名称：有具体身份时为角色名，否则为阵营名
+func (t *LogText) CheckResultName(r CheckResult) string {
+	if r.Role != "" {
+		if name, ok := t.RoleNames[r.Role]; ok {
+			return name
+		}
+		return string(r.Role)
+	}
+	if name, ok := t.FactionNames[r.Faction]; ok {
+		return name
+	}
+	return string(r.Faction)
+}
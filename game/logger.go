@@ -17,6 +17,9 @@
 package game
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -29,31 +32,94 @@ import (
 type GameLogger struct {
 	mu        sync.Mutex
 	gameID    string
+	logDir    string // 本局日志目录
+	text      *LogText
 	startTime time.Time
 	fullLog   strings.Builder
 	replayLog strings.Builder
+	comments  strings.Builder // 解说记录，保存为 commentary.md
+	events    []Event         // 结构化事件，保存为 events.jsonl
+	round     int             // 当前回合，用于标记事件
 }
 
 // NewGameLogger 创建游戏日志记录器
-func NewGameLogger() *GameLogger {
+// 日志写入 <baseDir>/<gameID>，gameID 由时间戳和随机后缀组成，多局并行时不会冲突
+// text 为日志使用的语言文案
+func NewGameLogger(baseDir string, text *LogText) *GameLogger {
 	now := time.Now()
+	gameID := now.Format("20060102_150405") + "_" + randomSuffix()
+
+	if baseDir == "" {
+		baseDir = "logs"
+	}
+	if abs, err := filepath.Abs(baseDir); err == nil {
+		baseDir = abs
+	}
+
 	return &GameLogger{
-		gameID:    now.Format("20060102_150405"),
+		gameID:    gameID,
+		logDir:    filepath.Join(baseDir, gameID),
+		text:      text,
 		startTime: now,
 	}
 }
 
+// randomSuffix 生成 6 位十六进制随机后缀
+func randomSuffix() string {
+	b := make([]byte, 3)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%06x", time.Now().UnixNano()&0xffffff)
+	}
+	return hex.EncodeToString(b)
+}
+
+// GameID 返回游戏 ID
+func (gl *GameLogger) GameID() string {
+	return gl.gameID
+}
+
+// LogDir 返回本局日志目录
+func (gl *GameLogger) LogDir() string {
+	return gl.logDir
+}
+
+// record 追加结构化事件，调用方需持有锁
+func (gl *GameLogger) record(e Event) {
+	e.Seq = len(gl.events) + 1
+	e.Time = time.Now()
+	if e.Round == 0 {
+		e.Round = gl.round
+	}
+	gl.events = append(gl.events, e)
+}
+
+// Events 返回目前为止记录的结构化事件
+func (gl *GameLogger) Events() []Event {
+	gl.mu.Lock()
+	defer gl.mu.Unlock()
+	events := make([]Event, len(gl.events))
+	copy(events, gl.events)
+	return events
+}
+
 // SetPlayers 设置玩家信息
 func (gl *GameLogger) SetPlayers(players map[string]Role) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
 
-	gl.fullLog.WriteString("# 🐺 狼人杀游戏完整日志\n\n")
-	gl.fullLog.WriteString(fmt.Sprintf("**游戏ID**: %s\n\n", gl.gameID))
-	gl.fullLog.WriteString(fmt.Sprintf("**开始时间**: %s\n\n", gl.startTime.Format("2006-01-02 15:04:05")))
+	roles := make(map[string]Role, len(players))
+	for name, role := range players {
+		roles[name] = role
+	}
+	gl.record(Event{Type: EventGameStart, Roles: roles})
+
+	t := gl.text
+	gl.fullLog.WriteString(t.FullTitle + "\n\n")
+	gl.fullLog.WriteString(fmt.Sprintf(t.GameID+"\n\n", gl.gameID))
+	gl.fullLog.WriteString(fmt.Sprintf(t.StartTime+"\n\n", gl.startTime.Format("2006-01-02 15:04:05")))
 	gl.fullLog.WriteString("---\n\n")
-	gl.fullLog.WriteString("## 📋 角色分配\n\n")
-	gl.fullLog.WriteString("| 玩家 | 角色 |\n")
+	gl.fullLog.WriteString(t.RoleAssignment + "\n\n")
+	gl.fullLog.WriteString(t.RoleTableHeader + "\n")
 	gl.fullLog.WriteString("|------|------|\n")
 	for name, role := range players {
 		gl.fullLog.WriteString(fmt.Sprintf("| %s | %s |\n", name, role))
@@ -61,15 +127,17 @@ func (gl *GameLogger) SetPlayers(players map[string]Role) {
 	gl.fullLog.WriteString("\n---\n\n")
 
 	// 回放日志
-	gl.replayLog.WriteString("# 🎮 狼人杀游戏回放\n\n")
-	gl.replayLog.WriteString(fmt.Sprintf("**游戏ID**: %s\n\n", gl.gameID))
-	gl.replayLog.WriteString("## 角色分配\n\n")
+	gl.replayLog.WriteString(t.ReplayTitle + "\n\n")
+	gl.replayLog.WriteString(fmt.Sprintf(t.GameID+"\n\n", gl.gameID))
+	gl.replayLog.WriteString(t.ReplayRoleAssignment + "\n\n")
 
-	var wolves, villagers, seer, witch, hunter []string
+	var wolves, hidden, villagers, seer, witch, hunter []string
 	for name, role := range players {
 		switch role {
 		case RoleWerewolf:
 			wolves = append(wolves, name)
+		case RoleHiddenWolf:
+			hidden = append(hidden, name)
 		case RoleVillager:
 			villagers = append(villagers, name)
 		case RoleSeer:
@@ -80,16 +148,19 @@ func (gl *GameLogger) SetPlayers(players map[string]Role) {
 			hunter = append(hunter, name)
 		}
 	}
-	gl.replayLog.WriteString(fmt.Sprintf("- **狼人**: %s\n", strings.Join(wolves, ", ")))
-	gl.replayLog.WriteString(fmt.Sprintf("- **村民**: %s\n", strings.Join(villagers, ", ")))
+	gl.replayLog.WriteString(fmt.Sprintf("- **%s**: %s\n", t.RoleNames[RoleWerewolf], strings.Join(wolves, ", ")))
+	if len(hidden) > 0 {
+		gl.replayLog.WriteString(fmt.Sprintf("- **%s**: %s\n", t.RoleNames[RoleHiddenWolf], strings.Join(hidden, ", ")))
+	}
+	gl.replayLog.WriteString(fmt.Sprintf("- **%s**: %s\n", t.RoleNames[RoleVillager], strings.Join(villagers, ", ")))
 	if len(seer) > 0 {
-		gl.replayLog.WriteString(fmt.Sprintf("- **预言家**: %s\n", seer[0]))
+		gl.replayLog.WriteString(fmt.Sprintf("- **%s**: %s\n", t.RoleNames[RoleSeer], seer[0]))
 	}
 	if len(witch) > 0 {
-		gl.replayLog.WriteString(fmt.Sprintf("- **女巫**: %s\n", witch[0]))
+		gl.replayLog.WriteString(fmt.Sprintf("- **%s**: %s\n", t.RoleNames[RoleWitch], witch[0]))
 	}
 	if len(hunter) > 0 {
-		gl.replayLog.WriteString(fmt.Sprintf("- **猎人**: %s\n", hunter[0]))
+		gl.replayLog.WriteString(fmt.Sprintf("- **%s**: %s\n", t.RoleNames[RoleHunter], hunter[0]))
 	}
 	gl.replayLog.WriteString("\n---\n\n")
 }
@@ -98,14 +169,17 @@ func (gl *GameLogger) SetPlayers(players map[string]Role) {
 func (gl *GameLogger) LogRound(round int) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString(fmt.Sprintf("## 🔄 第 %d 回合\n\n", round))
-	gl.replayLog.WriteString(fmt.Sprintf("## 第 %d 回合\n\n", round))
+	gl.round = round
+	gl.record(Event{Type: EventRound})
+	gl.fullLog.WriteString(fmt.Sprintf(gl.text.Round+"\n\n", round))
+	gl.replayLog.WriteString(fmt.Sprintf(gl.text.ReplayRound+"\n\n", round))
 }
 
 // LogPhase 记录阶段
 func (gl *GameLogger) LogPhase(phase string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventPhase, Content: phase})
 	gl.fullLog.WriteString(fmt.Sprintf("### %s\n\n", phase))
 }
 
@@ -113,7 +187,8 @@ func (gl *GameLogger) LogPhase(phase string) {
 func (gl *GameLogger) LogModerator(message string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString(fmt.Sprintf("🎭 **主持人**: %s\n\n", message))
+	gl.record(Event{Type: EventModeratorMsg, Content: message})
+	gl.fullLog.WriteString(fmt.Sprintf(gl.text.Moderator+"\n\n", message))
 }
 
 // LogAction 记录玩家行动（简洁版，只记录回复）
@@ -161,74 +236,102 @@ func (gl *GameLogger) LogEvent(event string) {
 func (gl *GameLogger) LogWerewolfDiscussionStart(wolves []string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString("### 🤝 狼人密谋\n\n")
-	gl.replayLog.WriteString(fmt.Sprintf("🤝 狼人密谋 (%s)\n", strings.Join(wolves, ", ")))
+	gl.fullLog.WriteString(gl.text.WolfConspiracy + "\n\n")
+	gl.replayLog.WriteString(fmt.Sprintf(gl.text.ReplayWolfConspiracy+"\n", strings.Join(wolves, ", ")))
 }
 
-// LogWerewolfDiscussion 记录狼人讨论
-func (gl *GameLogger) LogWerewolfDiscussion(wolf string, round int, message string) {
+// LogWerewolfDiscussion 记录狼人讨论，proposal 为本次发言提议的击杀目标，可以为空
+func (gl *GameLogger) LogWerewolfDiscussion(wolf string, round int, proposal, message string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	// 统一格式：🐺 **Player1**: 消息内容
+	gl.record(Event{Type: EventWolfSpeech, Actor: wolf, Target: proposal, Content: message})
+	// 统一格式：🐺 **Player1**: 消息内容，有提议时为 🐺 **Player1** → Player5: 消息内容
+	if proposal != "" {
+		gl.fullLog.WriteString(fmt.Sprintf("🐺 **%s** → %s: %s\n\n", wolf, proposal, message))
+		return
+	}
 	gl.fullLog.WriteString(fmt.Sprintf("🐺 **%s**: %s\n\n", wolf, message))
 }
 
-// LogWerewolfIndividualVote 记录单个狼人的投票
+// LogWerewolfIndividualVote 记录单个狼人协商结束时的最终提议
 func (gl *GameLogger) LogWerewolfIndividualVote(wolf, target string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString(fmt.Sprintf("- **%s** 投票: %s\n", wolf, target))
+	gl.record(Event{Type: EventWolfVote, Actor: wolf, Target: target})
+	gl.fullLog.WriteString(fmt.Sprintf(gl.text.WolfIndividualVote+"\n", wolf, target))
 }
 
 // LogWerewolfVote 记录狼人投票结果
 func (gl *GameLogger) LogWerewolfVote(target, details string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString(fmt.Sprintf("\n**狼人决定击杀**: %s (%s)\n\n", target, details))
-	gl.replayLog.WriteString(fmt.Sprintf("🐺 狼人击杀: %s\n\n", target))
+	gl.record(Event{Type: EventWolfKill, Target: target, Content: details})
+	gl.fullLog.WriteString(fmt.Sprintf("\n"+gl.text.WolfKill+"\n\n", target, details))
+	gl.replayLog.WriteString(fmt.Sprintf(gl.text.ReplayWolfKill+"\n\n", target))
+}
+
+// LogWerewolfNoKill 记录狼人空刀，事件的 Target 为空
+func (gl *GameLogger) LogWerewolfNoKill(details string) {
+	gl.mu.Lock()
+	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventWolfKill, Content: details})
+	gl.fullLog.WriteString(fmt.Sprintf("\n"+gl.text.WolfNoKill+"\n\n", details))
+	gl.replayLog.WriteString(gl.text.ReplayWolfNoKill + "\n\n")
 }
 
-// LogSeerCheck 记录预言家查验
-func (gl *GameLogger) LogSeerCheck(target, result string) {
+// LogSeerCheck 记录预言家查验，事件的 Faction 为表现出的阵营，按身份查验时 Content 为表现出的身份
+func (gl *GameLogger) LogSeerCheck(result CheckResult) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString(fmt.Sprintf("**预言家查验**: %s → %s\n\n", target, result))
-	gl.replayLog.WriteString(fmt.Sprintf("🔮 预言家查验 %s: %s\n\n", target, result))
+	gl.record(Event{Type: EventSeerCheck, Target: result.Target, Content: string(result.Role), Faction: result.Faction})
+	name := gl.text.CheckResultName(result)
+	gl.fullLog.WriteString(fmt.Sprintf(gl.text.SeerCheck+"\n\n", result.Target, name))
+	gl.replayLog.WriteString(fmt.Sprintf(gl.text.ReplaySeerCheck+"\n\n", result.Target, name))
 }
 
 // LogWitchSave 记录女巫救人
 func (gl *GameLogger) LogWitchSave(target string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString(fmt.Sprintf("**女巫使用解药**: 救活 %s\n\n", target))
-	gl.replayLog.WriteString(fmt.Sprintf("💊 女巫救活: %s\n\n", target))
+	gl.record(Event{Type: EventWitchSave, Target: target})
+	gl.fullLog.WriteString(fmt.Sprintf(gl.text.WitchSave+"\n\n", target))
+	gl.replayLog.WriteString(fmt.Sprintf(gl.text.ReplayWitchSave+"\n\n", target))
 }
 
 // LogWitchPoison 记录女巫毒人
 func (gl *GameLogger) LogWitchPoison(target string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString(fmt.Sprintf("**女巫使用毒药**: 毒杀 %s\n\n", target))
-	gl.replayLog.WriteString(fmt.Sprintf("☠️ 女巫毒杀: %s\n\n", target))
+	gl.record(Event{Type: EventWitchPoison, Target: target})
+	gl.fullLog.WriteString(fmt.Sprintf(gl.text.WitchPoison+"\n\n", target))
+	gl.replayLog.WriteString(fmt.Sprintf(gl.text.ReplayWitchPoison+"\n\n", target))
 }
 
 // LogNightSummary 记录夜晚结算
 func (gl *GameLogger) LogNightSummary(killed, poisoned, saved, shot string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString("**夜晚结算**:\n")
+	// 猎人开枪的死亡由 LogHunterShoot 记录
+	if killed != "" && saved == "" {
+		gl.record(Event{Type: EventDeath, Target: killed, Content: DeathKilled})
+	}
+	if poisoned != "" {
+		gl.record(Event{Type: EventDeath, Target: poisoned, Content: DeathPoisoned})
+	}
+
+	gl.fullLog.WriteString(gl.text.NightSummary + "\n")
 	if killed != "" {
 		if saved != "" {
-			gl.fullLog.WriteString(fmt.Sprintf("- 狼人击杀 %s，被女巫救活\n", killed))
+			gl.fullLog.WriteString(fmt.Sprintf(gl.text.NightKilledSaved+"\n", killed))
 		} else {
-			gl.fullLog.WriteString(fmt.Sprintf("- 狼人击杀 %s\n", killed))
+			gl.fullLog.WriteString(fmt.Sprintf(gl.text.NightKilled+"\n", killed))
 		}
 	}
 	if poisoned != "" {
-		gl.fullLog.WriteString(fmt.Sprintf("- 女巫毒杀 %s\n", poisoned))
+		gl.fullLog.WriteString(fmt.Sprintf(gl.text.NightPoisoned+"\n", poisoned))
 	}
 	if shot != "" {
-		gl.fullLog.WriteString(fmt.Sprintf("- 猎人射杀 %s\n", shot))
+		gl.fullLog.WriteString(fmt.Sprintf(gl.text.NightShot+"\n", shot))
 	}
 	gl.fullLog.WriteString("\n")
 }
@@ -237,25 +340,66 @@ func (gl *GameLogger) LogNightSummary(killed, poisoned, saved, shot string) {
 func (gl *GameLogger) LogDiscussion(player, message string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventSpeech, Actor: player, Content: message})
 	gl.fullLog.WriteString(fmt.Sprintf("**[%s]**: %s\n\n", player, message))
 }
 
+// LogClaim 记录发言附带的结构化内容（身份声明、查验结果、怀疑和信任的玩家）
+func (gl *GameLogger) LogClaim(player string, claim SpeechClaim) {
+	gl.mu.Lock()
+	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventClaim, Actor: player, Claim: &claim})
+}
+
+// LogBelief 记录玩家的概率判断（仅写入事件日志，不出现在对局记录中）
+func (gl *GameLogger) LogBelief(b Belief) {
+	gl.mu.Lock()
+	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventBelief, Round: b.Round, Actor: b.Player, Content: b.Phase, Beliefs: b.Probs})
+}
+
 // LogVote 记录投票
 func (gl *GameLogger) LogVote(voter, target string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventVote, Actor: voter, Target: target})
 	gl.fullLog.WriteString(fmt.Sprintf("- %s → %s\n", voter, target))
 }
 
+// LogAbstain 记录弃票
+func (gl *GameLogger) LogAbstain(voter string) {
+	gl.mu.Lock()
+	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventAbstain, Actor: voter})
+	gl.fullLog.WriteString(fmt.Sprintf(gl.text.VoteAbstain+"\n", voter))
+}
+
+// LogSpoiled 记录废票，reason 为目标不合法的 Violation 或 SpoiledNoResponse
+func (gl *GameLogger) LogSpoiled(voter, target, reason string) {
+	gl.mu.Lock()
+	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventSpoiled, Actor: voter, Target: target, Content: reason})
+	gl.fullLog.WriteString(fmt.Sprintf(gl.text.VoteSpoiled+"\n", voter, gl.text.SpoiledReason(reason)))
+}
+
+// LogForfeit 记录因目标多次不合法而作废的行动，完整日志中的说明由主持人消息给出
+func (gl *GameLogger) LogForfeit(actor string, action Action, target string, reason Violation) {
+	gl.mu.Lock()
+	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventForfeit, Actor: actor, Action: action, Target: target, Content: string(reason)})
+}
+
 // LogVoteResult 记录投票结果
 func (gl *GameLogger) LogVoteResult(eliminated, details string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventVoteResult, Target: eliminated, Content: details})
 	if eliminated != "" {
-		gl.fullLog.WriteString(fmt.Sprintf("\n**投票结果**: %s 被淘汰 (%s)\n\n", eliminated, details))
-		gl.replayLog.WriteString(fmt.Sprintf("🗳️ 投票淘汰: %s\n\n", eliminated))
+		gl.record(Event{Type: EventDeath, Target: eliminated, Content: DeathVoted})
+		gl.fullLog.WriteString(fmt.Sprintf("\n"+gl.text.VoteResult+"\n\n", eliminated, details))
+		gl.replayLog.WriteString(fmt.Sprintf(gl.text.ReplayVoteOut+"\n\n", eliminated))
 	} else {
-		gl.fullLog.WriteString(fmt.Sprintf("\n**投票结果**: %s\n\n", details))
+		gl.fullLog.WriteString(fmt.Sprintf("\n"+gl.text.VoteResultNone+"\n\n", details))
 	}
 }
 
@@ -263,16 +407,19 @@ func (gl *GameLogger) LogVoteResult(eliminated, details string) {
 func (gl *GameLogger) LogLastWords(player, message string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString(fmt.Sprintf("**[%s 遗言]**: %s\n\n", player, message))
-	gl.replayLog.WriteString(fmt.Sprintf("💀 %s 遗言: %s\n\n", player, message))
+	gl.record(Event{Type: EventLastWords, Actor: player, Content: message})
+	gl.fullLog.WriteString(fmt.Sprintf(gl.text.LastWords+"\n\n", player, message))
+	gl.replayLog.WriteString(fmt.Sprintf(gl.text.ReplayLastWords+"\n\n", player, message))
 }
 
 // LogHunterShoot 记录猎人开枪
 func (gl *GameLogger) LogHunterShoot(target string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
-	gl.fullLog.WriteString(fmt.Sprintf("**猎人开枪**: 射杀 %s\n\n", target))
-	gl.replayLog.WriteString(fmt.Sprintf("🔫 猎人射杀: %s\n\n", target))
+	gl.record(Event{Type: EventHunterShoot, Target: target})
+	gl.record(Event{Type: EventDeath, Target: target, Content: DeathShot})
+	gl.fullLog.WriteString(fmt.Sprintf(gl.text.HunterShoot+"\n\n", target))
+	gl.replayLog.WriteString(fmt.Sprintf(gl.text.ReplayHunterShoot+"\n\n", target))
 }
 
 // LogWinner 记录胜利者
@@ -280,19 +427,19 @@ func (gl *GameLogger) LogWinner(winner Faction, survivors []string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
 
-	winnerName := "好人阵营"
-	if winner == FactionWerewolf {
-		winnerName = "狼人阵营"
-	}
+	gl.record(Event{Type: EventGameOver, Winner: winner, Content: strings.Join(survivors, ", ")})
+
+	t := gl.text
+	winnerName := t.FactionNames[winner]
 
 	gl.fullLog.WriteString("---\n\n")
-	gl.fullLog.WriteString(fmt.Sprintf("## 🏆 游戏结束\n\n**胜利者**: %s\n\n", winnerName))
-	gl.fullLog.WriteString(fmt.Sprintf("**存活玩家**: %s\n\n", strings.Join(survivors, ", ")))
-	gl.fullLog.WriteString(fmt.Sprintf("**游戏时长**: %s\n\n", time.Since(gl.startTime).Round(time.Second)))
+	gl.fullLog.WriteString(fmt.Sprintf(t.GameOver+"\n\n"+t.Winner+"\n\n", winnerName))
+	gl.fullLog.WriteString(fmt.Sprintf(t.Survivors+"\n\n", strings.Join(survivors, ", ")))
+	gl.fullLog.WriteString(fmt.Sprintf(t.Duration+"\n\n", time.Since(gl.startTime).Round(time.Second)))
 
 	gl.replayLog.WriteString("---\n\n")
-	gl.replayLog.WriteString(fmt.Sprintf("## 🏆 %s 获胜！\n\n", winnerName))
-	gl.replayLog.WriteString(fmt.Sprintf("存活: %s\n", strings.Join(survivors, ", ")))
+	gl.replayLog.WriteString(fmt.Sprintf(t.ReplayWinner+"\n\n", winnerName))
+	gl.replayLog.WriteString(fmt.Sprintf(t.ReplaySurvivors+"\n", strings.Join(survivors, ", ")))
 }
 
 // LogReflection 记录玩家反思
@@ -300,20 +447,33 @@ func (gl *GameLogger) LogReflection(player, role, message string) {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
 	roleIcon := getRoleIcon(role)
-	// 移除 LLM 可能添加的 "反思:" 或 "反思：" 前缀
-	message = strings.TrimPrefix(message, "反思:")
-	message = strings.TrimPrefix(message, "反思：")
+	// 移除 LLM 可能添加的 "反思:" 之类的前缀
+	for _, prefix := range gl.text.ReflectionPrefixes {
+		message = strings.TrimPrefix(message, prefix)
+	}
 	message = strings.TrimSpace(message)
+	gl.record(Event{Type: EventReflection, Actor: player, Content: message})
 	gl.fullLog.WriteString(fmt.Sprintf("%s **%s**: 💭 %s\n\n", roleIcon, player, message))
 }
 
+// LogCommentary 记录解说员对某一阶段的点评，只写入解说记录，不出现在对局日志中
+func (gl *GameLogger) LogCommentary(phase, message string) {
+	gl.mu.Lock()
+	defer gl.mu.Unlock()
+	gl.record(Event{Type: EventCommentary, Content: message})
+	if gl.comments.Len() == 0 {
+		gl.comments.WriteString(gl.text.CommentaryTitle + "\n\n")
+	}
+	gl.comments.WriteString(fmt.Sprintf(gl.text.CommentaryPhase+"\n\n%s\n\n", gl.round, phase, message))
+}
+
 // Save 保存日志到文件
 func (gl *GameLogger) Save() error {
 	gl.mu.Lock()
 	defer gl.mu.Unlock()
 
 	// 创建日志目录
-	logDir := filepath.Join("logs", gl.gameID)
+	logDir := gl.logDir
 	if err := os.MkdirAll(logDir, 0755); err != nil {
 		return fmt.Errorf("创建日志目录失败: %w", err)
 	}
@@ -330,6 +490,29 @@ func (gl *GameLogger) Save() error {
 		return fmt.Errorf("保存回放日志失败: %w", err)
 	}
 
-	fmt.Printf("日志已保存到: %s\n", logDir)
+	// 保存解说记录
+	if gl.comments.Len() > 0 {
+		commentaryPath := filepath.Join(logDir, "commentary.md")
+		if err := os.WriteFile(commentaryPath, []byte(gl.comments.String()), 0644); err != nil {
+			return fmt.Errorf("保存解说记录失败: %w", err)
+		}
+	}
+
+	// 保存结构化事件
+	var events strings.Builder
+	for _, e := range gl.events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("序列化事件失败: %w", err)
+		}
+		events.Write(data)
+		events.WriteByte('\n')
+	}
+	eventsPath := filepath.Join(logDir, "events.jsonl")
+	if err := os.WriteFile(eventsPath, []byte(events.String()), 0644); err != nil {
+		return fmt.Errorf("保存事件日志失败: %w", err)
+	}
+
+	fmt.Printf(gl.text.Saved+"\n", logDir)
 	return nil
 }
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package game
+
+// GameResult 单局游戏结果（用于批量模拟统计）
+type GameResult struct {
+	GameID string  `json:"game_id"`
+	Seed   int64   `json:"seed"`   // 角色分配等随机过程使用的种子
+	Winner Faction `json:"winner"` // 为空表示超过最大回合数
+	Rounds int     `json:"rounds"`
+
+	// 座位到角色的分配
+	Roles map[string]Role `json:"roles"`
+
+	// 首夜被狼人击杀玩家的角色，为空表示首夜未击杀
+	FirstNightKill Role `json:"first_night_kill"`
+
+	SeerSurvived bool `json:"seer_survived"`
+
+	// 药水使用回合，0 表示未使用
+	HealingRound int `json:"healing_round"`
+	PoisonRound  int `json:"poison_round"`
+
+	// 工具调用未返回结构化结果、回退到文本解析的次数
+	Fallbacks int `json:"fallbacks"`
+
+	// 玩家使用的模型，以及好人阵营概率判断的评分（未开启 belief 追踪时为空）
+	Model  string       `json:"model,omitempty"`
+	Belief *Calibration `json:"belief,omitempty"`
+}
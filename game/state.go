@@ -31,6 +31,8 @@ const (
 	RoleSeer     Role = "seer"     // 预言家
 	RoleWitch    Role = "witch"    // 女巫
 	RoleHunter   Role = "hunter"   // 猎人
+
+	RoleHiddenWolf Role = "hidden_wolf" // 隐狼：与狼人一起夜间击杀，预言家查验时显示为好人
 )
 
 // Faction 阵营
@@ -41,6 +43,27 @@ const (
 	FactionVillager Faction = "villager" // 村民阵营
 )
 
+// Faction 返回角色所属阵营
+func (r Role) Faction() Faction {
+	if r == RoleWerewolf || r == RoleHiddenWolf {
+		return FactionWerewolf
+	}
+	return FactionVillager
+}
+
+// IsWolf 判断角色是否属于狼人阵营
+func (r Role) IsWolf() bool {
+	return r.Faction() == FactionWerewolf
+}
+
+// SeenAs 返回预言家查验该角色时看到的身份：隐狼显示为村民，其余角色如实显示
+func (r Role) SeenAs() Role {
+	if r == RoleHiddenWolf {
+		return RoleVillager
+	}
+	return r
+}
+
 // Player 玩家信息
 type Player struct {
 	Name  string
@@ -55,6 +78,12 @@ type GameState struct {
 	// 玩家信息
 	Players      map[string]*Player
 	AlivePlayers []string
+	Seats        []string // 座位顺序（含死亡玩家）
+
+	// 警长（未设置时为空）
+	Sheriff string
+	// 最近一名死亡的玩家
+	LastDead string
 
 	// 特殊角色
 	Seer   string
@@ -71,11 +100,22 @@ type GameState struct {
 	NightPoisoned string // 女巫毒杀目标
 	NightShot     string // 猎人射杀目标
 
+	// 板子规则
+	rules Rules
+
 	// 游戏状态
 	Round    int
 	Phase    string // "night" or "day"
 	FirstDay bool
 	Winner   Faction
+
+	// 结构化发言（speech 工具）
+	pendingClaims map[string]SpeechClaim
+	claims        []PublicClaim
+
+	// 概率判断（belief 工具）
+	pendingBeliefs []Belief
+	beliefs        []Belief
 }
 
 // NewGameState 创建游戏状态
@@ -98,6 +138,8 @@ func (gs *GameState) InitPlayers(names []string, roles []Role) {
 
 	gs.AlivePlayers = make([]string, len(names))
 	copy(gs.AlivePlayers, names)
+	gs.Seats = make([]string, len(names))
+	copy(gs.Seats, names)
 
 	for i, name := range names {
 		role := roles[i]
@@ -132,34 +174,43 @@ func (gs *GameState) GetAlivePlayers() []string {
 	return alive
 }
 
-// GetAliveWerewolves 获取存活狼人列表
+// GetAliveWerewolves 按座位顺序获取存活的狼人阵营玩家（含隐狼），第一个为狼首
 func (gs *GameState) GetAliveWerewolves() []string {
 	gs.mu.RLock()
 	defer gs.mu.RUnlock()
 
 	var wolves []string
-	for name, player := range gs.Players {
-		if player.Alive && player.Role == RoleWerewolf {
+	for _, name := range gs.Seats {
+		if player := gs.Players[name]; player.Alive && player.Role.IsWolf() {
 			wolves = append(wolves, name)
 		}
 	}
 	return wolves
 }
 
-// GetAliveVillagers 获取存活村民阵营列表
+// GetAliveVillagers 按座位顺序获取存活的村民阵营玩家
 func (gs *GameState) GetAliveVillagers() []string {
 	gs.mu.RLock()
 	defer gs.mu.RUnlock()
 
 	var villagers []string
-	for name, player := range gs.Players {
-		if player.Alive && player.Role != RoleWerewolf {
+	for _, name := range gs.Seats {
+		if player := gs.Players[name]; player.Alive && !player.Role.IsWolf() {
 			villagers = append(villagers, name)
 		}
 	}
 	return villagers
 }
 
+// HasPlayer 检查玩家是否在本局游戏中（无论存活与否）
+func (gs *GameState) HasPlayer(name string) bool {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	_, ok := gs.Players[name]
+	return ok
+}
+
 // IsAlive 检查玩家是否存活
 func (gs *GameState) IsAlive(name string) bool {
 	gs.mu.RLock()
@@ -189,6 +240,7 @@ func (gs *GameState) KillPlayer(name string) {
 
 	if player, ok := gs.Players[name]; ok {
 		player.Alive = false
+		gs.LastDead = name
 	}
 
 	// 更新存活玩家列表
@@ -201,6 +253,45 @@ func (gs *GameState) KillPlayer(name string) {
 	gs.AlivePlayers = alive
 }
 
+// AlivePlayersFrom 从指定玩家的下一个座位开始，按方向返回存活玩家
+// start 为空或不在座位上时从 1 号位开始；clockwise 为 false 时逆时针
+func (gs *GameState) AlivePlayersFrom(start string, clockwise bool) []string {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	n := len(gs.Seats)
+	if n == 0 {
+		return nil
+	}
+
+	step := 1
+	if !clockwise {
+		step = n - 1
+	}
+
+	// 默认从 1 号位开始（即从最后一个座位的下一位）
+	idx := n - 1
+	if !clockwise {
+		idx = 1 % n
+	}
+	for i, name := range gs.Seats {
+		if name == start {
+			idx = i
+			break
+		}
+	}
+
+	var order []string
+	for i := 0; i < n; i++ {
+		idx = (idx + step) % n
+		name := gs.Seats[idx]
+		if player, ok := gs.Players[name]; ok && player.Alive {
+			order = append(order, name)
+		}
+	}
+	return order
+}
+
 // ResetNightState 重置夜间状态
 func (gs *GameState) ResetNightState() {
 	gs.mu.Lock()
@@ -222,7 +313,7 @@ func (gs *GameState) CheckWinner() Faction {
 
 	for _, player := range gs.Players {
 		if player.Alive {
-			if player.Role == RoleWerewolf {
+			if player.Role.IsWolf() {
 				aliveWolves++
 			} else {
 				aliveVillagers++
@@ -241,14 +332,14 @@ func (gs *GameState) CheckWinner() Faction {
 	return ""
 }
 
-// GetRolesString 获取角色分配字符串
+// GetRolesString 按座位顺序获取角色分配字符串
 func (gs *GameState) GetRolesString() string {
 	gs.mu.RLock()
 	defer gs.mu.RUnlock()
 
 	var parts []string
-	for name, player := range gs.Players {
-		parts = append(parts, fmt.Sprintf("%s=%s", name, player.Role))
+	for _, name := range gs.Seats {
+		parts = append(parts, fmt.Sprintf("%s=%s", name, gs.Players[name].Role))
 	}
 	return strings.Join(parts, ", ")
 }
@@ -260,6 +351,34 @@ func (gs *GameState) SetNightKilled(target string) {
 	gs.NightKilled = target
 }
 
+// GetPhase 获取当前阶段："night" 或 "day"
+func (gs *GameState) GetPhase() string {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+	return gs.Phase
+}
+
+// SetPhase 设置当前阶段
+func (gs *GameState) SetPhase(phase string) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	gs.Phase = phase
+}
+
+// GetSheriff 获取当前警长，没有警长时为空
+func (gs *GameState) GetSheriff() string {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+	return gs.Sheriff
+}
+
+// SetSheriff 设置警长，为空表示撕掉警徽
+func (gs *GameState) SetSheriff(name string) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	gs.Sheriff = name
+}
+
 // GetNightKilled 获取狼人击杀目标
 func (gs *GameState) GetNightKilled() string {
 	gs.mu.RLock()
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAlivePlayersFrom(t *testing.T) {
+	tests := []struct {
+		name      string
+		start     string
+		clockwise bool
+		want      []string
+	}{
+		{"没有起点顺时针", "", true, []string{"Player1", "Player2", "Player4", "Player5", "Player6"}},
+		{"没有起点逆时针", "", false, []string{"Player1", "Player6", "Player5", "Player4", "Player2"}},
+		{"从存活玩家顺时针，自己最后", "Player4", true, []string{"Player5", "Player6", "Player1", "Player2", "Player4"}},
+		{"从存活玩家逆时针，自己最后", "Player4", false, []string{"Player2", "Player1", "Player6", "Player5", "Player4"}},
+		{"从死者顺时针", "Player3", true, []string{"Player4", "Player5", "Player6", "Player1", "Player2"}},
+		{"从死者逆时针", "Player3", false, []string{"Player2", "Player1", "Player6", "Player5", "Player4"}},
+		{"起点不在座位上", "Player9", true, []string{"Player1", "Player2", "Player4", "Player5", "Player6"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := NewGameState()
+			gs.InitPlayers(
+				[]string{"Player1", "Player2", "Player3", "Player4", "Player5", "Player6"},
+				[]Role{RoleWerewolf, RoleVillager, RoleVillager, RoleSeer, RoleWitch, RoleHunter},
+			)
+			gs.KillPlayer("Player3")
+
+			if got := gs.AlivePlayersFrom(tt.start, tt.clockwise); !slices.Equal(got, tt.want) {
+				t.Errorf("AlivePlayersFrom(%q, %v) = %v，期望 %v", tt.start, tt.clockwise, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeatOrderedViews(t *testing.T) {
+	seats := []string{"Player1", "Player2", "Player3", "Player4", "Player5", "Player6"}
+	gs := NewGameState()
+	gs.InitPlayers(seats, []Role{RoleVillager, RoleWerewolf, RoleSeer, RoleVillager, RoleWitch, RoleHunter})
+	gs.KillPlayer("Player4")
+
+	wantRoles := "Player1=villager, Player2=werewolf, Player3=seer, Player4=villager, Player5=witch, Player6=hunter"
+	wantVillagers := []string{"Player1", "Player3", "Player5", "Player6"}
+	// map 的遍历顺序每次不同，重复多次才能发现按 map 顺序输出的实现
+	for i := 0; i < 20; i++ {
+		if got := gs.GetRolesString(); got != wantRoles {
+			t.Fatalf("GetRolesString() = %q，期望 %q", got, wantRoles)
+		}
+		if got := gs.GetAliveVillagers(); !slices.Equal(got, wantVillagers) {
+			t.Fatalf("GetAliveVillagers() = %v，期望 %v", got, wantVillagers)
+		}
+	}
+}
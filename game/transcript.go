@@ -0,0 +1,108 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// 对话记录中的消息角色
+const (
+	TranscriptSystem    = "system"
+	TranscriptUser      = "user"
+	TranscriptAssistant = "assistant"
+	TranscriptTool      = "tool"
+)
+
+// ToolCall 玩家发起的一次工具调用
+type ToolCall struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// TranscriptMessage 玩家对话中的一条消息
+type TranscriptMessage struct {
+	Role      string     `json:"role"`
+	Content   string     `json:"content,omitempty"`
+	ToolCalls []ToolCall `json:"tool_calls,omitempty"` // 助手消息发起的工具调用
+	ToolName  string     `json:"tool_name,omitempty"`  // 工具结果对应的工具
+}
+
+// Transcript 一个座位看到的完整对话：系统提示、主持人的每条消息、玩家的回复以及工具调用和结果
+type Transcript struct {
+	Seat     string              `json:"seat"`
+	Role     Role                `json:"role"`
+	Messages []TranscriptMessage `json:"messages"`
+}
+
+// SaveTranscripts 把每个座位的对话记录写入 seats/<座位>.json 和 seats/<座位>.md
+// 可以在游戏过程中多次调用，后一次覆盖前一次
+func (gl *GameLogger) SaveTranscripts(transcripts []Transcript) error {
+	dir := filepath.Join(gl.logDir, "seats")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("创建座位记录目录失败: %w", err)
+	}
+
+	for _, t := range transcripts {
+		data, err := json.MarshalIndent(t, "", "  ")
+		if err != nil {
+			return fmt.Errorf("序列化 %s 的对话记录失败: %w", t.Seat, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, t.Seat+".json"), data, 0644); err != nil {
+			return fmt.Errorf("保存 %s 的对话记录失败: %w", t.Seat, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, t.Seat+".md"), []byte(gl.transcriptMarkdown(t)), 0644); err != nil {
+			return fmt.Errorf("保存 %s 的对话记录失败: %w", t.Seat, err)
+		}
+	}
+	return nil
+}
+
+// transcriptMarkdown 把对话记录渲染为 Markdown
+func (gl *GameLogger) transcriptMarkdown(t Transcript) string {
+	text := gl.text
+	roleName := string(t.Role)
+	if name, ok := text.RoleNames[t.Role]; ok {
+		roleName = name
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(text.TranscriptTitle+"\n\n", t.Seat, roleName))
+	for _, msg := range t.Messages {
+		switch msg.Role {
+		case TranscriptSystem:
+			sb.WriteString(text.TranscriptSystem + "\n\n")
+		case TranscriptUser:
+			sb.WriteString(text.TranscriptUser + "\n\n")
+		case TranscriptAssistant:
+			sb.WriteString(fmt.Sprintf(text.TranscriptAssistant+"\n\n", t.Seat))
+		case TranscriptTool:
+			sb.WriteString(fmt.Sprintf(text.TranscriptToolResult+"\n\n", msg.ToolName))
+		}
+		if msg.Content != "" {
+			sb.WriteString(msg.Content + "\n\n")
+		}
+		for _, call := range msg.ToolCalls {
+			sb.WriteString(fmt.Sprintf(text.TranscriptToolCall+"\n\n", call.Name, call.Arguments))
+		}
+	}
+	return sb.String()
+}
@@ -18,42 +18,230 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 
 	"github.com/cloudwego/eino/adk"
 
 	"github.com/ashwinyue/wolf-go-adk/agents/supervisor"
+	"github.com/ashwinyue/wolf-go-adk/analysis"
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/memory"
 	"github.com/ashwinyue/wolf-go-adk/params"
+	"github.com/ashwinyue/wolf-go-adk/replay"
+	"github.com/ashwinyue/wolf-go-adk/server"
+	"github.com/ashwinyue/wolf-go-adk/simulation"
+	"github.com/ashwinyue/wolf-go-adk/telemetry"
+	"github.com/ashwinyue/wolf-go-adk/utils"
 	"github.com/cloudwego/eino-examples/adk/common/prints"
 	"github.com/cloudwego/eino-examples/adk/common/trace"
 )
 
+// command 命令行子命令
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, args []string)
+}
+
+// commands 返回全部子命令，不带子命令时运行 play
+func commands() []command {
+	return []command{
+		{"play", "运行一局游戏并在终端实时输出", runPlay},
+		{"simulate", "批量运行多局游戏并输出统计报告", runSimulate},
+		{"experiment", "成对运行提示词 A/B 实验", runExperiment},
+		{"analyze", "根据 events.jsonl 重新生成赛后分析", func(_ context.Context, args []string) { runAnalyze(args) }},
+		{"lessons", "用模型归纳跨局经验库", runLessons},
+		{"replay", "在终端逐阶段回放已结束的对局（上帝视角或单个座位的视角）", func(_ context.Context, args []string) { runReplay(args) }},
+		{"serve", "提供对局日志和指标的 HTTP 服务", func(_ context.Context, args []string) { runServe(args) }},
+		{"validate-board", "校验板子配置文件", func(_ context.Context, args []string) { runValidateBoard(args) }},
+	}
+}
+
 func main() {
 	// 加载环境变量
 	if err := godotenv.Load(); err != nil {
 		log.Println("No .env file found, using environment variables")
 	}
 
-	// 语言设置：GAME_LANG=zh 使用中文，默认英文
-	if strings.ToLower(os.Getenv("GAME_LANG")) == "zh" {
-		params.UseChinese()
-		log.Println("使用中文模式")
+	ctx := context.Background()
+
+	// go run . [子命令] [参数]，省略子命令（或第一个参数就是 flag）时运行 play
+	name, args := "play", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+	if name == "help" {
+		printUsage(os.Stdout)
+		return
 	}
+	for _, c := range commands() {
+		if c.name == name {
+			c.run(ctx, args)
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "未知命令: %s\n\n", name)
+	printUsage(os.Stderr)
+	os.Exit(2)
+}
 
-	ctx := context.Background()
+// printUsage 打印子命令列表
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "用法: go run . <命令> [参数]")
+	fmt.Fprintln(w, "\n命令:")
+	for _, c := range commands() {
+		fmt.Fprintf(w, "  %-15s %s\n", c.name, c.usage)
+	}
+	fmt.Fprintln(w, "\n使用 go run . <命令> -h 查看命令的参数")
+}
+
+// gameFlags play、simulate 和 experiment 共用的游戏参数
+type gameFlags struct {
+	*modelFlags
+	fs         *flag.FlagSet
+	board      string
+	seed       int64
+	maxRounds  int
+	wolfRounds int
+	deadSeats  string
+}
+
+// modelFlags 游戏命令和 lessons 共用的模型与语言参数
+type modelFlags struct {
+	lang     string
+	prompts  string
+	provider string
+	model    string
+}
+
+// addGameFlags 在子命令的 FlagSet 上注册共用的游戏参数
+func addGameFlags(fs *flag.FlagSet) *gameFlags {
+	f := &gameFlags{fs: fs}
+	fs.StringVar(&f.board, "board", "", "板子配置文件（YAML），为空时使用默认板子并按 DISCUSSION_* 环境变量覆盖")
+	fs.Int64Var(&f.seed, "seed", 0, "随机种子，为 0 时使用当前时间；多局时后续每局（每对）依次加一")
+	f.modelFlags = addModelFlags(fs)
+	fs.IntVar(&f.maxRounds, "max-rounds", 0, "最大游戏回合数，必须大于 0；不传时使用板子配置")
+	fs.IntVar(&f.wolfRounds, "wolf-rounds", 0, "狼人夜间讨论的最大轮数，必须大于 0；不传时使用板子配置")
+	fs.StringVar(&f.deadSeats, "dead-seats", "", "出局玩家策略 observe / spectate / silent，为空时使用板子配置")
+	return f
+}
+
+// addModelFlags 在子命令的 FlagSet 上注册模型、语言和提示词包参数
+func addModelFlags(fs *flag.FlagSet) *modelFlags {
+	f := &modelFlags{}
+	fs.StringVar(&f.lang, "lang", "", "游戏语言 zh / en / ja，为空时读取 GAME_LANG")
+	fs.StringVar(&f.prompts, "prompts", "", "提示词包文件（YAML），为空时按语言使用内置提示词")
+	fs.StringVar(&f.provider, "provider", "", "模型提供方，为空时读取 MODEL_TYPE")
+	fs.StringVar(&f.model, "model", "", "模型名称，覆盖提供方对应的环境变量（如 OPENAI_MODEL）")
+	return f
+}
+
+// modelConfig 按 -provider 和 -model 选择模型，参数无效时退出
+func (f *modelFlags) modelConfig() utils.ModelConfig {
+	cfg := utils.ModelConfig{Provider: f.provider, Model: f.model}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("模型配置无效: %v", err)
+	}
+	return cfg
+}
+
+// boardConfig 加载板子配置并应用命令行覆盖，覆盖后的配置无效时退出
+func (f *gameFlags) boardConfig() params.BoardConfig {
+	board := params.BoardFromEnv()
+	if f.board != "" {
+		var err error
+		if board, err = params.LoadBoard(f.board); err != nil {
+			log.Fatalf("加载板子配置失败: %v", err)
+		}
+	}
+	// 板子中的 0 表示使用默认值，命令行显式传入的回合数必须大于 0
+	if f.isSet("max-rounds") {
+		if f.maxRounds <= 0 {
+			log.Fatalf("-max-rounds 必须大于 0，收到 %d", f.maxRounds)
+		}
+		board.MaxRounds = f.maxRounds
+	}
+	if f.isSet("wolf-rounds") {
+		if f.wolfRounds <= 0 {
+			log.Fatalf("-wolf-rounds 必须大于 0，收到 %d", f.wolfRounds)
+		}
+		board.WolfDiscussionRounds = f.wolfRounds
+	}
+	if f.deadSeats != "" {
+		board.DeadSeats = params.DeadSeatPolicy(strings.ToLower(f.deadSeats))
+	}
+	if err := board.Validate(); err != nil {
+		log.Fatalf("板子配置无效: %v", err)
+	}
+	return board
+}
+
+// isSet 判断命令行是否显式传入了该参数
+func (f *gameFlags) isSet(name string) bool {
+	set := false
+	f.fs.Visit(func(fl *flag.Flag) {
+		if fl.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// locale 加载语言包
+func (f *modelFlags) locale() *params.Locale {
+	return newLocale(f.prompts, f.lang)
+}
+
+// 终端输出级别
+const (
+	verbosityQuiet   = 0 // 只输出最终结果
+	verbosityNormal  = 1 // play 输出主持人消息和截断为一行的玩家发言，simulate 输出每局进度，serve 输出启动信息
+	verbosityVerbose = 2 // play 逐字流式输出玩家发言，simulate 同时输出每局结果，serve 记录每个请求
+)
+
+// runPlay 运行一局游戏并在终端实时输出
+func runPlay(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("play", flag.ExitOnError)
+	gf := addGameFlags(fs)
+	logDir := fs.String("log-dir", os.Getenv("LOG_DIR"), "日志根目录，默认读取 LOG_DIR，为空时使用 logs")
+	verbosity := fs.Int("v", verbosityVerbose, "输出级别：0 只输出结果，1 输出主持人消息，2 逐字输出玩家发言")
+	recordLessons := fs.Bool("record-lessons", false, "把赛后反思中的经验追加到 LESSONS_DIR 经验库")
+	_ = fs.Parse(args)
+	locale := gf.locale()
 
 	// 初始化追踪（可选）
 	traceCloseFn, startSpanFn := trace.AppendCozeLoopCallbackIfConfigured(ctx)
 	defer traceCloseFn(ctx)
+	defer setupTelemetry(ctx)()
 
 	// 创建主持人 Agent（Supervisor 模式）
 	// 这是一个自定义 Agent，作为 Supervisor 编排所有玩家 Agent
-	moderator, err := supervisor.NewModeratorAgent(ctx)
+	moderator, err := supervisor.NewModeratorAgent(ctx, supervisor.GameConfig{
+		Board:  gf.boardConfig(),
+		Locale: locale,
+		LogDir: *logDir,
+		Seed:   gf.seed,
+		Model:  gf.modelConfig(),
+
+		TrackBeliefs:     os.Getenv("BELIEF_TRACKING") == "true",
+		StructuredSpeech: os.Getenv("STRUCTURED_SPEECH") == "true",
+		Commentary:       os.Getenv("COMMENTARY") == "true",
+
+		Memory:        newMemory(),
+		RecordLessons: *recordLessons,
+		InjectLessons: injectLessons(),
+	})
 	if err != nil {
 		log.Fatalf("创建主持人 Agent 失败: %v", err)
 	}
@@ -63,7 +251,7 @@ func main() {
 
 	// 创建 Runner
 	runner := adk.NewRunner(ctx, adk.RunnerConfig{
-		EnableStreaming: true,
+		EnableStreaming: *verbosity >= verbosityVerbose,
 		Agent:           moderator,
 	})
 
@@ -82,11 +270,374 @@ func main() {
 			break
 		}
 
-		prints.Event(event)
+		if *verbosity > verbosityQuiet {
+			prints.Event(event)
+		}
 		if event.Output != nil {
 			lastMessage, _, _ = adk.GetMessage(event)
 		}
 	}
 
 	endSpanFn(ctx, lastMessage)
+
+	result := moderator.Result()
+	winner := string(result.Winner)
+	if winner == "" {
+		winner = "-"
+	}
+	fmt.Printf(locale.I18n.GameSummary+"\n", result.GameID, winner, result.Rounds)
+}
+
+// runSimulate 批量运行多局游戏并输出统计报告
+func runSimulate(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("simulate", flag.ExitOnError)
+	games := fs.Int("n", 10, "模拟局数")
+	concurrency := fs.Int("concurrency", 2, "同时进行的最大局数")
+	outDir := fs.String("out", filepath.Join("logs", "simulation_"+time.Now().Format("20060102_150405")), "报告输出目录")
+	noCache := fs.Bool("no-cache", false, "不使用 LLM_CACHE_DIR 中的响应缓存")
+	recordLessons := fs.Bool("record-lessons", false, "把赛后反思中的经验追加到 LESSONS_DIR 经验库")
+	verbosity := fs.Int("v", verbosityNormal, "输出级别：0 只输出报告，1 输出每局进度，2 同时输出每局的胜利方和回合数")
+	gf := addGameFlags(fs)
+	_ = fs.Parse(args)
+	defer setupTelemetry(ctx)()
+	if *noCache {
+		utils.DisableResponseCache()
+	}
+
+	report, err := simulation.Run(ctx, simulation.Config{
+		Games:       *games,
+		Concurrency: *concurrency,
+		Board:       gf.boardConfig(),
+		Locale:      gf.locale(),
+		LogDir:      filepath.Join(*outDir, "games"),
+		Seed:        gf.seed,
+		Model:       gf.modelConfig(),
+		Verbosity:   *verbosity,
+
+		TrackBeliefs:     os.Getenv("BELIEF_TRACKING") == "true",
+		StructuredSpeech: os.Getenv("STRUCTURED_SPEECH") == "true",
+
+		Memory:        newMemory(),
+		RecordLessons: *recordLessons,
+		InjectLessons: injectLessons(),
+	})
+	if err != nil {
+		log.Fatalf("批量模拟失败: %v", err)
+	}
+	printCacheStats()
+
+	fmt.Println(report.Markdown())
+	if err := report.Save(*outDir); err != nil {
+		log.Fatalf("保存报告失败: %v", err)
+	}
+}
+
+// runExperiment 成对运行对照组和实验组，输出指标差异和显著性检验
+func runExperiment(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("experiment", flag.ExitOnError)
+	file := fs.String("f", "", "实验定义文件（YAML）")
+	pairs := fs.Int("n", 10, "成对对局数，每对包含对照组和实验组各一局")
+	concurrency := fs.Int("concurrency", 2, "同时进行的最大局数")
+	outDir := fs.String("out", filepath.Join("logs", "experiment_"+time.Now().Format("20060102_150405")), "报告输出目录")
+	noCache := fs.Bool("no-cache", false, "不使用 LLM_CACHE_DIR 中的响应缓存")
+	gf := addGameFlags(fs)
+	_ = fs.Parse(args)
+	if *noCache {
+		utils.DisableResponseCache()
+	}
+
+	if *file == "" {
+		log.Fatalf("请通过 -f 指定实验定义文件")
+	}
+	exp, err := params.LoadExperiment(*file)
+	if err != nil {
+		log.Fatalf("加载实验定义失败: %v", err)
+	}
+	defer setupTelemetry(ctx)()
+
+	report, err := simulation.RunExperiment(ctx, simulation.ExperimentConfig{
+		Experiment:  exp,
+		Pairs:       *pairs,
+		Concurrency: *concurrency,
+		Seed:        gf.seed,
+		Board:       gf.boardConfig(),
+		Locale:      gf.locale(),
+		LogDir:      filepath.Join(*outDir, "games"),
+		Model:       gf.modelConfig(),
+
+		TrackBeliefs:     os.Getenv("BELIEF_TRACKING") == "true",
+		StructuredSpeech: os.Getenv("STRUCTURED_SPEECH") == "true",
+
+		Memory: newMemory(),
+	})
+	if err != nil {
+		log.Fatalf("提示词实验失败: %v", err)
+	}
+	printCacheStats()
+
+	fmt.Println(report.Markdown())
+	if err := report.Save(*outDir); err != nil {
+		log.Fatalf("保存报告失败: %v", err)
+	}
+}
+
+// runAnalyze 根据已结束游戏的 events.jsonl 重新生成赛后分析
+func runAnalyze(args []string) {
+	if len(args) == 0 {
+		log.Fatalf("用法: go run . analyze <游戏日志目录>...")
+	}
+
+	locale := newLocale("", "")
+	for _, dir := range args {
+		events, err := game.LoadEvents(filepath.Join(dir, "events.jsonl"))
+		if err != nil {
+			log.Fatalf("读取 %s 失败: %v", dir, err)
+		}
+
+		report := analysis.Analyze(events, locale)
+		report.GameID = filepath.Base(dir)
+		if err := report.Save(dir); err != nil {
+			log.Fatalf("保存赛后分析失败: %v", err)
+		}
+		fmt.Printf("赛后分析已保存到: %s\n", dir)
+	}
+}
+
+// runLessons 用模型把经验库中每个模型、每个角色的原始经验归纳为最有价值的几条
+func runLessons(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("lessons", flag.ExitOnError)
+	dir := fs.String("dir", os.Getenv("LESSONS_DIR"), "经验库目录，默认读取 LESSONS_DIR")
+	top := fs.Int("top", 5, "每个角色保留的经验条数")
+	mf := addModelFlags(fs)
+	_ = fs.Parse(args)
+	modelCfg := mf.modelConfig()
+
+	if *dir == "" {
+		log.Fatalf("请通过 -dir 或 LESSONS_DIR 指定经验库目录")
+	}
+	store := memory.NewStore(*dir)
+	models, err := store.Models()
+	if err != nil {
+		log.Fatalf("读取经验库失败: %v", err)
+	}
+	if len(models) == 0 {
+		log.Fatalf("经验库 %s 中没有任何经验", *dir)
+	}
+
+	locale := mf.locale()
+	cm, err := utils.NewChatModel(ctx, modelCfg)
+	if err != nil {
+		log.Fatalf("创建模型失败: %v", err)
+	}
+	for _, model := range models {
+		summaries, err := memory.Summarize(ctx, cm, store, locale, model, *top)
+		if err != nil {
+			log.Fatalf("归纳经验失败: %v", err)
+		}
+		for _, sum := range summaries {
+			fmt.Printf("\n## %s / %s（%d 条原始经验）\n", model, sum.Role, sum.Games)
+			for _, l := range sum.Lessons {
+				fmt.Printf("- %s\n", l)
+			}
+		}
+	}
+}
+
+// runReplay 根据 events.jsonl 在终端逐阶段回放已结束的对局
+func runReplay(args []string) {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	logDir := fs.String("log-dir", os.Getenv("LOG_DIR"), "日志根目录，参数为游戏 ID 时在其中查找")
+	seat := fs.String("seat", "", "以该座位的视角回放（只显示该玩家当时能看到的内容），为空时为上帝视角")
+	player := fs.String("player", "", "只显示与该玩家相关的事件")
+	round := fs.Int("round", 0, "从第几回合开始回放")
+	lang := fs.String("lang", "", "回放语言 zh / en / ja，为空时读取 GAME_LANG")
+	noPause := fs.Bool("no-pause", false, "一次输出全部内容，不逐阶段等待输入（标准输入不是终端时自动开启）")
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		log.Fatalf("用法: go run . replay [-seat PlayerN] [-player PlayerN] [-round N] <游戏 ID 或日志目录>")
+	}
+
+	events, err := game.LoadEvents(filepath.Join(gameDir(*logDir, fs.Arg(0)), "events.jsonl"))
+	if err != nil {
+		log.Fatalf("读取事件日志失败: %v", err)
+	}
+	steps, err := replay.Steps(events, replay.Options{Seat: *seat, Player: *player})
+	if err != nil {
+		log.Fatalf("回放失败: %v", err)
+	}
+	start := replay.Seek(steps, *round)
+	if start < 0 {
+		log.Fatalf("对局中没有第 %d 回合", *round)
+	}
+
+	text := &newLocale("", *lang).I18n.Log
+	fmt.Println(replay.Header(*seat, replay.Roles(events), text))
+	fmt.Println()
+	replay.Play(os.Stdout, os.Stdin, steps, start, text, !*noPause && isTerminal(os.Stdin))
+}
+
+// isTerminal 判断文件是否为交互式终端
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// runServe 启动对局日志服务，前端回放可以直接读取，同时提供 /metrics
+func runServe(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "监听地址")
+	logDir := fs.String("log-dir", os.Getenv("LOG_DIR"), "日志根目录，为空时使用 logs")
+	staticDir := fs.String("static", "", "前端静态文件目录（如 web/out），为空时只提供接口")
+	verbosity := fs.Int("v", verbosityNormal, "输出级别：0 只输出错误，1 输出服务地址，2 同时记录每个请求")
+	_ = fs.Parse(args)
+
+	handler := server.New(*logDir, *staticDir).Handler()
+	if *verbosity >= verbosityVerbose {
+		handler = logRequests(handler)
+	}
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	if *verbosity > verbosityQuiet {
+		log.Printf("对局日志服务: http://%s/data/games.json", displayAddr(*addr))
+	}
+	if err := srv.ListenAndServe(); err != nil {
+		log.Fatalf("日志服务退出: %v", err)
+	}
+}
+
+// runValidateBoard 校验板子配置文件，有任何文件无效时以非零状态退出
+func runValidateBoard(args []string) {
+	if len(args) == 0 {
+		log.Fatalf("用法: go run . validate-board <板子配置文件>...")
+	}
+
+	failed := false
+	for _, path := range args {
+		board, err := params.LoadBoard(path)
+		if err != nil {
+			fmt.Printf("❌ %s: %v\n", path, err)
+			failed = true
+			continue
+		}
+		fmt.Printf("✅ %s: %s（发言顺序 %s，同时发言 %v，讨论 %d 轮，反驳轮 %v，最多 %d 回合，狼人讨论 %d 轮（%s），空刀 %v，自刀 %v，查验 %s，隐狼 %d，出局玩家 %s）\n",
+			path, board.Name, board.Discussion.Order, board.Discussion.Parallel, board.Discussion.Rounds, board.Discussion.Rebuttal,
+			board.GameRounds(), board.WolfRounds(), board.WolfRule(), board.EmptyKill, board.SelfKnife, board.CheckMode(), board.HiddenWolves, board.DeadPolicy())
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// gameDir 把游戏 ID 解析为日志目录，参数本身是目录时直接使用
+func gameDir(logDir, arg string) string {
+	if info, err := os.Stat(arg); err == nil && info.IsDir() {
+		return arg
+	}
+	if logDir == "" {
+		logDir = "logs"
+	}
+	return filepath.Join(logDir, arg)
+}
+
+// logRequests 记录每个请求的方法、路径、状态码和耗时
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		log.Printf("%s %s %d %v", r.Method, r.URL.Path, rec.status, time.Since(start).Round(time.Millisecond))
+	})
+}
+
+// statusRecorder 记录响应状态码
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader 记录状态码后写入响应头
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// displayAddr 把只有端口的监听地址补全为 localhost
+func displayAddr(addr string) string {
+	if strings.HasPrefix(addr, ":") {
+		return "localhost" + addr
+	}
+	return addr
+}
+
+// setupTelemetry 按 OTEL_TRACES_EXPORTER 和 METRICS_ADDR 初始化 OpenTelemetry 链路和 Prometheus 指标，返回关闭函数
+func setupTelemetry(ctx context.Context) func() {
+	cfg := telemetry.ConfigFromEnv()
+	shutdown, err := telemetry.Setup(ctx, cfg)
+	if err != nil {
+		log.Fatalf("初始化可观测性失败: %v", err)
+	}
+	if cfg.MetricsAddr != "" {
+		log.Printf("指标服务: %s/metrics", cfg.MetricsAddr)
+	}
+	return func() {
+		if err := shutdown(context.Background()); err != nil {
+			log.Printf("%v", err)
+		}
+	}
+}
+
+// printCacheStats 启用响应缓存时打印命中统计
+func printCacheStats() {
+	cache := utils.SharedResponseCache()
+	if cache == nil {
+		return
+	}
+	hits, misses, errs := cache.Stats()
+	if total := hits + misses; total > 0 {
+		log.Printf("响应缓存: 命中 %d，未命中 %d，命中率 %.1f%%", hits, misses, float64(hits)/float64(total)*100)
+	}
+	if errs > 0 {
+		log.Printf("响应缓存: %d 次写入失败，请检查 LLM_CACHE_DIR 是否可写", errs)
+	}
+}
+
+// newMemory 根据 LESSONS_DIR 创建跨局经验库，未设置时不启用
+func newMemory() *memory.Store {
+	dir := os.Getenv("LESSONS_DIR")
+	if dir == "" {
+		return nil
+	}
+	return memory.NewStore(dir)
+}
+
+// injectLessons 每个角色注入的往届经验条数（LESSONS_INJECT），默认不注入
+func injectLessons() int {
+	n, err := strconv.Atoi(os.Getenv("LESSONS_INJECT"))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// newLocale 加载语言包：指定提示词包时从文件加载，否则按 lang 选择内置语言，lang 为空时读取 GAME_LANG
+// en 使用英文，ja 使用日文，默认中文
+func newLocale(promptsPath, lang string) *params.Locale {
+	if promptsPath != "" {
+		locale, err := params.LoadLocale(promptsPath)
+		if err != nil {
+			log.Fatalf("加载提示词包失败: %v", err)
+		}
+		log.Printf("提示词包: %s (语言: %s)", promptsPath, locale.Lang)
+		return locale
+	}
+
+	if lang == "" {
+		lang = os.Getenv("GAME_LANG")
+	}
+	locale := params.NewLocale(lang)
+	log.Printf("游戏语言: %s", locale.Lang)
+	return locale
 }
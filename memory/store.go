@@ -0,0 +1,240 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+// Lesson 玩家在一局游戏结束后总结的一条经验
+type Lesson struct {
+	Model  string    `json:"model"`
+	Role   game.Role `json:"role"`
+	GameID string    `json:"game_id"`
+	Player string    `json:"player"`
+	Won    bool      `json:"won"` // 该玩家所在阵营是否获胜
+	Text   string    `json:"text"`
+	Time   time.Time `json:"time"`
+}
+
+// Summary 某个模型在某个角色上跨多局归纳出的经验
+type Summary struct {
+	Model     string    `json:"model"`
+	Role      game.Role `json:"role"`
+	Games     int       `json:"games"` // 参与归纳的经验条数
+	Lessons   []string  `json:"lessons"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Store 本地经验库，按模型分目录保存为 JSON 文件：
+//
+//	<dir>/<model>/lessons.jsonl       每局追加的原始经验
+//	<dir>/<model>/summary_<role>.json 按角色归纳后的经验
+//
+// 同一进程内的多局游戏可以共享一个 Store 并发写入
+type Store struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewStore 创建经验库
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Dir 返回经验库目录
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Add 追加经验
+func (s *Store) Add(lessons ...Lesson) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byModel := make(map[string][]Lesson)
+	for _, l := range lessons {
+		byModel[modelDir(l.Model)] = append(byModel[modelDir(l.Model)], l)
+	}
+	for model, ls := range byModel {
+		dir := filepath.Join(s.dir, model)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("创建经验库目录失败: %w", err)
+		}
+		f, err := os.OpenFile(filepath.Join(dir, "lessons.jsonl"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return fmt.Errorf("打开经验库失败: %w", err)
+		}
+		for _, l := range ls {
+			data, err := json.Marshal(l)
+			if err != nil {
+				f.Close()
+				return fmt.Errorf("序列化经验失败: %w", err)
+			}
+			if _, err := f.Write(append(data, '\n')); err != nil {
+				f.Close()
+				return fmt.Errorf("写入经验库失败: %w", err)
+			}
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("写入经验库失败: %w", err)
+		}
+	}
+	return nil
+}
+
+// Models 返回经验库中已有的模型目录
+func (s *Store) Models() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取经验库目录失败: %w", err)
+	}
+	var models []string
+	for _, e := range entries {
+		if e.IsDir() {
+			models = append(models, e.Name())
+		}
+	}
+	return models, nil
+}
+
+// Lessons 读取某个模型的全部原始经验，按记录顺序返回
+func (s *Store) Lessons(model string) ([]Lesson, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.Open(filepath.Join(s.dir, modelDir(model), "lessons.jsonl"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("打开经验库失败: %w", err)
+	}
+	defer f.Close()
+
+	var lessons []Lesson
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var l Lesson
+		if err := json.Unmarshal(scanner.Bytes(), &l); err != nil {
+			return nil, fmt.Errorf("解析经验库第 %d 行失败: %w", line, err)
+		}
+		lessons = append(lessons, l)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("读取经验库失败: %w", err)
+	}
+	return lessons, nil
+}
+
+// SaveSummary 保存某个模型在某个角色上的归纳经验，覆盖旧的归纳
+func (s *Store) SaveSummary(sum Summary) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir := filepath.Join(s.dir, modelDir(sum.Model))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("创建经验库目录失败: %w", err)
+	}
+	data, err := json.MarshalIndent(sum, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化归纳经验失败: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "summary_"+string(sum.Role)+".json"), data, 0644); err != nil {
+		return fmt.Errorf("保存归纳经验失败: %w", err)
+	}
+	return nil
+}
+
+// Summary 读取某个模型在某个角色上的归纳经验，尚未归纳时返回 nil
+func (s *Store) Summary(model string, role game.Role) (*Summary, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(filepath.Join(s.dir, modelDir(model), "summary_"+string(role)+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取归纳经验失败: %w", err)
+	}
+	var sum Summary
+	if err := json.Unmarshal(data, &sum); err != nil {
+		return nil, fmt.Errorf("解析归纳经验失败: %w", err)
+	}
+	return &sum, nil
+}
+
+// Top 返回某个模型在某个角色上最值得参考的 n 条经验
+// 优先使用归纳后的经验；尚未归纳时取最近的经验，获胜局的经验排在前面
+func (s *Store) Top(model string, role game.Role, n int) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	sum, err := s.Summary(model, role)
+	if err != nil {
+		return nil, err
+	}
+	if sum != nil && len(sum.Lessons) > 0 {
+		return sum.Lessons[:min(n, len(sum.Lessons))], nil
+	}
+
+	lessons, err := s.Lessons(model)
+	if err != nil {
+		return nil, err
+	}
+	var won, lost []string
+	for i := len(lessons) - 1; i >= 0; i-- {
+		l := lessons[i]
+		if l.Role != role || slices.Contains(won, l.Text) || slices.Contains(lost, l.Text) {
+			continue
+		}
+		if l.Won {
+			won = append(won, l.Text)
+		} else {
+			lost = append(lost, l.Text)
+		}
+	}
+	top := append(won, lost...)
+	return top[:min(n, len(top))], nil
+}
+
+// modelDir 模型名对应的目录名，未知模型归入 unknown
+func modelDir(model string) string {
+	if model == "" {
+		return "unknown"
+	}
+	return strings.NewReplacer("/", "_", "\\", "_", ":", "_", " ", "_").Replace(model)
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// maxSummarizeInput 每次归纳最多使用的原始经验条数（取最近的）
+const maxSummarizeInput = 60
+
+// Summarize 用模型把某个模型目录下每个角色的原始经验归纳为最多 top 条，并保存到经验库
+func Summarize(ctx context.Context, cm model.BaseChatModel, store *Store, locale *params.Locale, modelName string, top int) ([]Summary, error) {
+	lessons, err := store.Lessons(modelName)
+	if err != nil {
+		return nil, err
+	}
+
+	byRole := make(map[game.Role][]Lesson)
+	for _, l := range lessons {
+		byRole[l.Role] = append(byRole[l.Role], l)
+	}
+
+	var summaries []Summary
+	for _, role := range []game.Role{game.RoleWerewolf, game.RoleHiddenWolf, game.RoleVillager, game.RoleSeer, game.RoleWitch, game.RoleHunter} {
+		ls := byRole[role]
+		if len(ls) == 0 {
+			continue
+		}
+		if len(ls) > maxSummarizeInput {
+			ls = ls[len(ls)-maxSummarizeInput:]
+		}
+
+		var sb strings.Builder
+		for _, l := range ls {
+			result := "loss"
+			if l.Won {
+				result = "win"
+			}
+			sb.WriteString(fmt.Sprintf("- [%s] %s\n", result, l.Text))
+		}
+
+		roleName := locale.I18n.RoleNames[role]
+		prompt := fmt.Sprintf(locale.Prompts.ToSummarizeLessons, roleName, sb.String(), top)
+		msg, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage(prompt)})
+		if err != nil {
+			return nil, fmt.Errorf("归纳%s经验失败: %w", roleName, err)
+		}
+
+		sum := Summary{
+			Model:     modelName,
+			Role:      role,
+			Games:     len(ls),
+			Lessons:   parseLessons(msg.Content, top),
+			UpdatedAt: time.Now(),
+		}
+		if err := store.SaveSummary(sum); err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, sum)
+	}
+	return summaries, nil
+}
+
+// parseLessons 从模型输出中逐行提取经验，去掉列表符号和编号
+func parseLessons(content string, top int) []string {
+	var lessons []string
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimLeft(line, "-*•0123456789.、) ")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		lessons = append(lessons, line)
+		if len(lessons) == top {
+			break
+		}
+	}
+	return lessons
+}
@@ -0,0 +1,346 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package params
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+// SpeakingOrder 白天发言顺序策略
+type SpeakingOrder string
+
+const (
+	OrderSeat             SpeakingOrder = "seat"             // 按座位顺序（默认）
+	OrderClockwise        SpeakingOrder = "clockwise"        // 从最近死者的下一位顺时针
+	OrderCounterClockwise SpeakingOrder = "counterclockwise" // 从最近死者的上一位逆时针
+	OrderSheriff          SpeakingOrder = "sheriff"          // 警长决定方向，警长最后发言
+	OrderRandom           SpeakingOrder = "random"           // 随机顺序
+)
+
+// speakingOrders 支持的发言顺序
+var speakingOrders = []SpeakingOrder{OrderSeat, OrderClockwise, OrderCounterClockwise, OrderSheriff, OrderRandom}
+
+// DeadSeatPolicy 出局玩家的消息策略
+type DeadSeatPolicy string
+
+const (
+	DeadObserve  DeadSeatPolicy = "observe"  // 出局后继续接收全部公开消息（默认，与引入该配置之前的行为相同）
+	DeadSpectate DeadSeatPolicy = "spectate" // 出局后不再接收消息，反思前收到一份出局后的摘要
+	DeadSilent   DeadSeatPolicy = "silent"   // 出局后不再接收消息，赛后只凭出局前的记忆反思
+)
+
+// deadSeatPolicies 支持的出局玩家策略
+var deadSeatPolicies = []DeadSeatPolicy{DeadObserve, DeadSpectate, DeadSilent}
+
+// WolfDecisionRule 狼人协商未达成一致时决定击杀目标的规则
+type WolfDecisionRule string
+
+const (
+	WolfMajority WolfDecisionRule = "majority" // 取最多狼人提议的目标，平票时取发言顺序靠前的狼人的提议（默认）
+	WolfLeader   WolfDecisionRule = "leader"   // 由狼首（座位最靠前的存活狼人）的提议决定
+	WolfRandom   WolfDecisionRule = "random"   // 从各狼人的提议中随机选择
+)
+
+// wolfDecisionRules 支持的狼人决定规则
+var wolfDecisionRules = []WolfDecisionRule{WolfMajority, WolfLeader, WolfRandom}
+
+// seerCheckModes 支持的预言家查验粒度
+var seerCheckModes = []game.CheckMode{game.CheckFaction, game.CheckRole}
+
+// DiscussionConfig 白天讨论配置
+type DiscussionConfig struct {
+	Order    SpeakingOrder `yaml:"order" json:"order"`       // 发言顺序
+	Rounds   int           `yaml:"rounds" json:"rounds"`     // 讨论轮数
+	Rebuttal bool          `yaml:"rebuttal" json:"rebuttal"` // 是否开启针对指控的反驳轮
+	Parallel bool          `yaml:"parallel" json:"parallel"` // 是否同时发言：每轮所有人同时发言，只能看到上一轮的发言，结束后按发言顺序公开
+}
+
+// BoardConfig 板子配置
+type BoardConfig struct {
+	Name       string           `yaml:"name" json:"name"`
+	Discussion DiscussionConfig `yaml:"discussion" json:"discussion"`
+
+	// 最大游戏回合数，超过后游戏以无人获胜结束；为 0 时使用 DefaultMaxGameRound
+	MaxRounds int `yaml:"max_rounds" json:"max_rounds,omitempty"`
+	// 狼人夜间讨论的最大轮数（每轮所有存活狼人各发言一次）；为 0 时使用 DefaultMaxDiscussionRound
+	WolfDiscussionRounds int `yaml:"wolf_discussion_rounds" json:"wolf_discussion_rounds,omitempty"`
+	// 狼人协商未达成一致时的决定规则；为空时使用 WolfMajority
+	WolfDecision WolfDecisionRule `yaml:"wolf_decision" json:"wolf_decision,omitempty"`
+	// 狼人是否可以空刀（提议 none，今晚不击杀任何人）
+	EmptyKill bool `yaml:"empty_kill" json:"empty_kill,omitempty"`
+	// 狼人是否可以自刀（击杀狼人同伴）
+	SelfKnife bool `yaml:"self_knife" json:"self_knife,omitempty"`
+	// 预言家查验结果的粒度：faction 只告知好人或狼人，role 告知具体身份；为空时使用 faction
+	SeerCheck game.CheckMode `yaml:"seer_check" json:"seer_check,omitempty"`
+	// 用隐狼替换的狼人数量，隐狼被预言家查验时显示为好人
+	HiddenWolves int `yaml:"hidden_wolves" json:"hidden_wolves,omitempty"`
+	// 出局玩家的消息策略；为空时使用 DeadObserve
+	DeadSeats DeadSeatPolicy `yaml:"dead_seats" json:"dead_seats,omitempty"`
+	// 白天放逐所需的最低投票率：有效票（不含弃票和废票）至少占存活人数的该比例才会有人出局；为 0 时不限制
+	VoteQuorum float64 `yaml:"vote_quorum" json:"vote_quorum,omitempty"`
+}
+
+// DefaultBoard 默认板子：9 人局，按座位顺序单轮发言
+var DefaultBoard = BoardConfig{
+	Name: "standard-9",
+	Discussion: DiscussionConfig{
+		Order:  OrderSeat,
+		Rounds: 1,
+	},
+	MaxRounds:            DefaultMaxGameRound,
+	WolfDiscussionRounds: DefaultMaxDiscussionRound,
+	WolfDecision:         WolfMajority,
+	DeadSeats:            DeadObserve,
+	SeerCheck:            game.CheckFaction,
+}
+
+// Roles 返回本板子发出的全部角色（未洗牌）：3 狼人 + 3 村民 + 1 预言家 + 1 女巫 + 1 猎人，
+// 其中 HiddenWolves 个狼人替换为隐狼
+func (b BoardConfig) Roles() []game.Role {
+	roles := []game.Role{
+		game.RoleWerewolf, game.RoleWerewolf, game.RoleWerewolf,
+		game.RoleVillager, game.RoleVillager, game.RoleVillager,
+		game.RoleSeer, game.RoleWitch, game.RoleHunter,
+	}
+	for i := 0; i < b.HiddenWolves && i < WerewolfCount; i++ {
+		roles[i] = game.RoleHiddenWolf
+	}
+	return roles
+}
+
+// GameRounds 返回最大游戏回合数
+func (b BoardConfig) GameRounds() int {
+	if b.MaxRounds > 0 {
+		return b.MaxRounds
+	}
+	return DefaultMaxGameRound
+}
+
+// WolfRounds 返回狼人夜间讨论的最大轮数
+func (b BoardConfig) WolfRounds() int {
+	if b.WolfDiscussionRounds > 0 {
+		return b.WolfDiscussionRounds
+	}
+	return DefaultMaxDiscussionRound
+}
+
+// WolfRule 返回狼人协商未达成一致时的决定规则
+func (b BoardConfig) WolfRule() WolfDecisionRule {
+	if b.WolfDecision != "" {
+		return b.WolfDecision
+	}
+	return WolfMajority
+}
+
+// CheckMode 返回预言家查验结果的粒度
+func (b BoardConfig) CheckMode() game.CheckMode {
+	if b.SeerCheck != "" {
+		return b.SeerCheck
+	}
+	return game.CheckFaction
+}
+
+// DeadPolicy 返回出局玩家的消息策略
+func (b BoardConfig) DeadPolicy() DeadSeatPolicy {
+	if b.DeadSeats != "" {
+		return b.DeadSeats
+	}
+	return DeadObserve
+}
+
+// QuorumVotes 返回存活 alive 人时放逐所需的最少有效票数，至少为 1
+func (b BoardConfig) QuorumVotes(alive int) int {
+	required := int(math.Ceil(b.VoteQuorum * float64(alive)))
+	if required < 1 {
+		return 1
+	}
+	return required
+}
+
+// Validate 校验板子配置，返回全部问题
+func (b BoardConfig) Validate() error {
+	var errs []string
+	if b.Name == "" {
+		errs = append(errs, "name 不能为空")
+	}
+	valid := false
+	for _, o := range speakingOrders {
+		if b.Discussion.Order == o {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		names := make([]string, len(speakingOrders))
+		for i, o := range speakingOrders {
+			names[i] = string(o)
+		}
+		errs = append(errs, fmt.Sprintf("discussion.order=%q 无效，可选: %s", b.Discussion.Order, strings.Join(names, ", ")))
+	}
+	if b.Discussion.Rounds < 1 {
+		errs = append(errs, "discussion.rounds 必须大于 0")
+	}
+	if b.MaxRounds < 0 {
+		errs = append(errs, "max_rounds 不能为负数")
+	}
+	if b.WolfDiscussionRounds < 0 {
+		errs = append(errs, "wolf_discussion_rounds 不能为负数")
+	}
+
+	if b.VoteQuorum < 0 || b.VoteQuorum > 1 {
+		errs = append(errs, "vote_quorum 必须在 0 到 1 之间")
+	}
+	if b.WolfDecision != "" && !validWolfDecision(b.WolfDecision) {
+		names := make([]string, len(wolfDecisionRules))
+		for i, r := range wolfDecisionRules {
+			names[i] = string(r)
+		}
+		errs = append(errs, fmt.Sprintf("wolf_decision=%q 无效，可选: %s", b.WolfDecision, strings.Join(names, ", ")))
+	}
+	if b.SeerCheck != "" && !validSeerCheck(b.SeerCheck) {
+		names := make([]string, len(seerCheckModes))
+		for i, c := range seerCheckModes {
+			names[i] = string(c)
+		}
+		errs = append(errs, fmt.Sprintf("seer_check=%q 无效，可选: %s", b.SeerCheck, strings.Join(names, ", ")))
+	}
+	if b.HiddenWolves < 0 || b.HiddenWolves > WerewolfCount {
+		errs = append(errs, fmt.Sprintf("hidden_wolves 必须在 0 到 %d 之间", WerewolfCount))
+	}
+	if b.DeadSeats != "" && !validDeadSeats(b.DeadSeats) {
+		names := make([]string, len(deadSeatPolicies))
+		for i, p := range deadSeatPolicies {
+			names[i] = string(p)
+		}
+		errs = append(errs, fmt.Sprintf("dead_seats=%q 无效，可选: %s", b.DeadSeats, strings.Join(names, ", ")))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("板子 %s 校验失败:\n  %s", b.Name, strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// validWolfDecision 判断狼人决定规则是否受支持
+func validWolfDecision(r WolfDecisionRule) bool {
+	for _, v := range wolfDecisionRules {
+		if r == v {
+			return true
+		}
+	}
+	return false
+}
+
+// validSeerCheck 判断预言家查验粒度是否受支持
+func validSeerCheck(c game.CheckMode) bool {
+	for _, v := range seerCheckModes {
+		if c == v {
+			return true
+		}
+	}
+	return false
+}
+
+// validDeadSeats 判断出局玩家策略是否受支持
+func validDeadSeats(p DeadSeatPolicy) bool {
+	for _, v := range deadSeatPolicies {
+		if p == v {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadBoard 从 YAML 文件加载板子配置并校验，文件中未出现的字段沿用 DefaultBoard
+func LoadBoard(path string) (BoardConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return BoardConfig{}, fmt.Errorf("读取板子配置失败: %w", err)
+	}
+
+	board := DefaultBoard
+	if err := yaml.Unmarshal(data, &board); err != nil {
+		return BoardConfig{}, fmt.Errorf("解析板子配置 %s 失败: %w", path, err)
+	}
+	board.Discussion.Order = SpeakingOrder(strings.ToLower(string(board.Discussion.Order)))
+	board.WolfDecision = WolfDecisionRule(strings.ToLower(string(board.WolfDecision)))
+	board.DeadSeats = DeadSeatPolicy(strings.ToLower(string(board.DeadSeats)))
+	board.SeerCheck = game.CheckMode(strings.ToLower(string(board.SeerCheck)))
+	if err := board.Validate(); err != nil {
+		return BoardConfig{}, err
+	}
+	return board, nil
+}
+
+// BoardFromEnv 基于默认板子，按环境变量覆盖讨论配置：
+//   - DISCUSSION_ORDER: seat / clockwise / counterclockwise / sheriff / random
+//   - DISCUSSION_ROUNDS: 讨论轮数
+//   - DISCUSSION_REBUTTAL: true 开启反驳轮
+//   - DISCUSSION_PARALLEL: true 同时发言，可与任意发言顺序组合
+//   - WOLF_DECISION: majority / leader / random
+//   - EMPTY_KILL: true 允许狼人空刀
+//   - SELF_KNIFE: true 允许狼人自刀
+//   - SEER_CHECK: faction / role
+//   - HIDDEN_WOLVES: 用隐狼替换的狼人数量
+//   - DEAD_SEATS: spectate / observe / silent
+//   - VOTE_QUORUM: 放逐所需的最低投票率（0 到 1）
+func BoardFromEnv() BoardConfig {
+	board := DefaultBoard
+
+	if order := strings.ToLower(os.Getenv("DISCUSSION_ORDER")); order != "" {
+		board.Discussion.Order = SpeakingOrder(order)
+	}
+	if rounds, err := strconv.Atoi(os.Getenv("DISCUSSION_ROUNDS")); err == nil && rounds > 0 {
+		board.Discussion.Rounds = rounds
+	}
+	if os.Getenv("DISCUSSION_REBUTTAL") == "true" {
+		board.Discussion.Rebuttal = true
+	}
+	if os.Getenv("DISCUSSION_PARALLEL") == "true" {
+		board.Discussion.Parallel = true
+	}
+	if rule := WolfDecisionRule(strings.ToLower(os.Getenv("WOLF_DECISION"))); validWolfDecision(rule) {
+		board.WolfDecision = rule
+	}
+	if os.Getenv("EMPTY_KILL") == "true" {
+		board.EmptyKill = true
+	}
+	if os.Getenv("SELF_KNIFE") == "true" {
+		board.SelfKnife = true
+	}
+	if mode := game.CheckMode(strings.ToLower(os.Getenv("SEER_CHECK"))); validSeerCheck(mode) {
+		board.SeerCheck = mode
+	}
+	if hidden, err := strconv.Atoi(os.Getenv("HIDDEN_WOLVES")); err == nil && hidden >= 0 && hidden <= WerewolfCount {
+		board.HiddenWolves = hidden
+	}
+	if policy := DeadSeatPolicy(strings.ToLower(os.Getenv("DEAD_SEATS"))); validDeadSeats(policy) {
+		board.DeadSeats = policy
+	}
+	if quorum, err := strconv.ParseFloat(os.Getenv("VOTE_QUORUM"), 64); err == nil && quorum >= 0 && quorum <= 1 {
+		board.VoteQuorum = quorum
+	}
+	return board
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package params
+
+import "testing"
+
+func TestQuorumVotes(t *testing.T) {
+	tests := []struct {
+		name   string
+		quorum float64
+		alive  int
+		want   int
+	}{
+		{"不设门槛", 0, 9, 1},
+		{"过半向上取整", 0.5, 9, 5},
+		{"过半整除", 0.5, 8, 4},
+		{"全部存活玩家", 1, 7, 7},
+		{"没有存活玩家", 0.5, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := DefaultBoard
+			board.VoteQuorum = tt.quorum
+			if got := board.QuorumVotes(tt.alive); got != tt.want {
+				t.Errorf("QuorumVotes(%d) = %d，期望 %d", tt.alive, got, tt.want)
+			}
+		})
+	}
+}
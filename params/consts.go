@@ -16,8 +16,14 @@
 
 package params
 
-// 游戏常量
+// 游戏规则的默认值，可以通过板子配置（BoardConfig）或命令行参数覆盖
 const (
-	MaxDiscussionRound = 3  // 狼人最大讨论轮数
-	MaxGameRound       = 10 // 最大游戏回合数
+	DefaultMaxDiscussionRound = 3  // 狼人最大讨论轮数（乘以存活狼人数）
+	DefaultMaxGameRound       = 10 // 最大游戏回合数
 )
+
+// WerewolfCount 标准 9 人局的狼人数量，板子的 hidden_wolves 不能超过该值
+const WerewolfCount = 3
+
+// MaxTargetRetries 玩家选择了不合法的目标时，主持人说明原因后重新询问的最大次数
+const MaxTargetRetries = 2
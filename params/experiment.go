@@ -0,0 +1,116 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package params
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+// PromptVariant 针对某个角色或座位的提示词改动
+// Role 和 Seat 至少指定一个，同时指定时两者都要匹配
+type PromptVariant struct {
+	Role     game.Role `yaml:"role" json:"role,omitempty"`         // 目标角色，为空表示任意角色
+	Seat     string    `yaml:"seat" json:"seat,omitempty"`         // 目标座位（如 Player3），为空表示任意座位
+	Guidance string    `yaml:"guidance" json:"guidance,omitempty"` // 替换 RoleGuidance
+	Append   string    `yaml:"append" json:"append,omitempty"`     // 追加到系统提示末尾
+}
+
+// Matches 判断变体是否作用于指定玩家
+func (v PromptVariant) Matches(name string, role game.Role) bool {
+	if v.Role != "" && v.Role != role {
+		return false
+	}
+	if v.Seat != "" && v.Seat != name {
+		return false
+	}
+	return true
+}
+
+// ExperimentArm 实验的一组提示词变体，为空表示使用基线提示词
+// Lessons 大于 0 时，每个角色注入经验库中该角色的前 Lessons 条往届经验
+type ExperimentArm struct {
+	Name     string          `yaml:"name" json:"name"`
+	Variants []PromptVariant `yaml:"variants" json:"variants"`
+	Lessons  int             `yaml:"lessons" json:"lessons,omitempty"`
+}
+
+// Experiment 提示词 A/B 实验定义
+// 对照组和实验组使用相同的种子（即相同的角色分配）成对运行，只有变体不同
+type Experiment struct {
+	Name        string        `yaml:"name" json:"name"`
+	Description string        `yaml:"description" json:"description"`
+	Control     ExperimentArm `yaml:"control" json:"control"`
+	Treatment   ExperimentArm `yaml:"treatment" json:"treatment"`
+}
+
+// LoadExperiment 从 YAML 文件加载实验定义并校验
+func LoadExperiment(path string) (*Experiment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取实验定义失败: %w", err)
+	}
+
+	var exp Experiment
+	if err := yaml.Unmarshal(data, &exp); err != nil {
+		return nil, fmt.Errorf("解析实验定义 %s 失败: %w", path, err)
+	}
+	if exp.Control.Name == "" {
+		exp.Control.Name = "control"
+	}
+	if exp.Treatment.Name == "" {
+		exp.Treatment.Name = "treatment"
+	}
+
+	var errs []string
+	if len(exp.Treatment.Variants) == 0 && exp.Treatment.Lessons == exp.Control.Lessons {
+		errs = append(errs, "实验组没有任何提示词变体，且注入的经验条数与对照组相同")
+	}
+	for _, arm := range []ExperimentArm{exp.Control, exp.Treatment} {
+		if arm.Lessons < 0 {
+			errs = append(errs, fmt.Sprintf("%s 的 lessons 不能为负数", arm.Name))
+		}
+		for i, v := range arm.Variants {
+			prefix := fmt.Sprintf("%s 第 %d 个变体", arm.Name, i+1)
+			if v.Role == "" && v.Seat == "" {
+				errs = append(errs, prefix+"未指定 role 或 seat")
+			}
+			if v.Role != "" {
+				if _, ok := RoleGuidance[v.Role]; !ok {
+					errs = append(errs, fmt.Sprintf("%s的角色 %s 不存在", prefix, v.Role))
+				}
+			}
+			if v.Guidance == "" && v.Append == "" {
+				errs = append(errs, prefix+"未指定 guidance 或 append")
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("实验定义 %s 校验失败:\n  %s", path, strings.Join(errs, "\n  "))
+	}
+	if exp.Name == "" {
+		exp.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return &exp, nil
+}
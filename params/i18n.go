@@ -16,8 +16,22 @@
 
 package params
 
+import (
+	"strings"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
 // I18nStrings 国际化字符串
 type I18nStrings struct {
+	// 角色与阵营名称
+	RoleNames map[game.Role]string
+
+	// 系统提示中的角色构成
+	RoleCount      string // 数量、角色名
+	RoleSeparator  string
+	HiddenWolfNote string // 有隐狼时附加在角色构成之后的说明
+
 	// 游戏流程
 	GameStarted    string
 	Players        string
@@ -26,14 +40,17 @@ type I18nStrings struct {
 	NightPhase     string
 	DayPhase       string
 	GameEnded      string
+	Announcement   string
 
 	// 狼人
 	WerewolvesDiscussing  string
 	WerewolfRound         string
+	WerewolfProposal      string // 狼人、轮次、提议目标、理由
 	WerewolvesAgreed      string
 	WerewolvesNoAgreement string
-	WerewolvesVoteTo      string
+	WerewolvesNoTarget    string
 	WerewolvesDecided     string
+	WerewolvesEmptyKill   string // 协商详情
 
 	// 女巫
 	WitchDeciding string
@@ -53,50 +70,238 @@ type I18nStrings struct {
 	// 白天
 	AlivePlayers    string
 	DiscussionPhase string
+	DiscussionRound string
 	PlayerSpeaks    string
 	VotingPhase     string
 	PlayerVotes     string
+	PlayerAbstains  string
+	PlayerSpoiled   string // 投票人、原因
 	NoValidVotes    string
+	VoteNoQuorum    string // 有效票数、存活人数、所需票数、票型
 	VoteResult      string
 
+	// 发言顺序与反驳
+	NoSheriff        string
+	SheriffDirection string
+	Clockwise        string
+	CounterClockwise string
+	RebuttalPhase    string
+	Rebuttal         string
+
 	// 遗言
 	LastWords string
 
 	// 猎人
 	HunterShot string
 
+	// 目标校验
+	TargetRejected string
+
 	// 胜利
 	WerewolvesWin string
 	VillagersWin  string
 	Roles         string
+	FinalRoles    string
+	StatusAlive   string
+	StatusDead    string
 
 	// 反思
 	PlayerReflections string
 	Reflection        string
 
+	// 主持人日志
+	ModDeaths       string
+	ModPeace        string
+	ModDiscussStart string
+	ModVoteStart    string
+	ModNightFall    string
+	ModWolvesWake   string
+	ModWitchWake    string
+	ModSeerWake     string
+	ModDawn         string
+	ModSheriff      string
+
+	// 日志阶段标题
+	PhaseNight      string
+	PhaseDay        string
+	PhaseDiscussion string
+	PhaseRebuttal   string
+	PhaseVote       string
+
 	// 错误
 	Error string
+
+	// 身份声明的说法（%s 为角色名），用于赛后分析识别玩家跳身份
+	ClaimPhrases []string
+
+	// 反思中经验总结行的前缀，用于提取写入经验库的内容
+	LessonPrefixes []string
+
+	// 结构化发言汇总
+	ClaimRole     string
+	ClaimCheck    string
+	ClaimGood     string
+	ClaimSuspects string
+	ClaimTrusted  string
+	CounterClaim  string
+	ClaimsSummary string
+
+	// 解说
+	Commentary string
+
+	// 命令行输出
+	GameSummary        string // 对局 ID、胜利方、回合数
+	SimulationProgress string // 完成局数、总局数
+	ExperimentProgress string // 完成局数、总局数
+
+	// 玩家工具的说明和返回结果
+	Tools ToolText
+
+	// 赛后分析和模拟报告文案
+	Report ReportText
+
+	// 日志文件文案
+	Log game.LogText
 }
 
-// I18n 当前使用的国际化字符串
-var I18n = ChineseI18n
+// ToolSpec 一个玩家工具的说明，Params 为参数说明，键为参数的 JSON 字段名
+type ToolSpec struct {
+	Desc   string
+	Params map[string]string
+}
+
+// ToolText 玩家工具的文案：工具和参数说明随工具定义发给模型，结果文案作为工具调用的返回值
+type ToolText struct {
+	Discuss   ToolSpec
+	Kill      ToolSpec
+	Check     ToolSpec
+	Save      ToolSpec
+	Poison    ToolSpec
+	Shoot     ToolSpec
+	Vote      ToolSpec
+	Speech    ToolSpec
+	Belief    ToolSpec
+	Direction ToolSpec
+
+	Killed          string // 目标
+	NoNightKill     string
+	Saved           string // 目标
+	NoSave          string
+	NoPoisonLeft    string
+	NoPoison        string
+	NoPoisonTarget  string
+	Poisoned        string // 目标
+	NoShot          string
+	NoShootTarget   string
+	Shot            string // 目标
+	Abstained       string
+	Voted           string // 目标
+	SpeechRecorded  string
+	NoBeliefs       string
+	BeliefsRecorded string // 玩家数
+	BadDirection    string
+	DirectionChosen string // 方向
+}
+
+// ReportText 赛后分析（analysis.md）、批量模拟报告（report.md）和提示词实验报告（experiment.md）的文案
+// 表头只给出标题行，分隔行由 TableHeader 生成
+type ReportText struct {
+	None string // 没有胜者、首夜没有击杀等空值
+
+	// 赛后分析
+	AnalysisTitle      string
+	AnalysisSummary    string // 胜利阵营、回合数、好人投票命中率
+	PlayerMetrics      string
+	PlayerTableHeader  string
+	SeerChecks         string
+	NoChecks           string
+	ChecksTableHeader  string
+	ClaimedChecks      string
+	ClaimedTableHeader string
+	FakeCheck          string
+	CounterClaims      string
+	FalseClaims        string
+	FalseClaim         string // 回合、玩家、真实身份、声称的身份
+	Beliefs            string
+	BeliefSummary      string // 判断条数、Brier、对数损失
+	BeliefTableHeader  string
+	VoteFlow           string
+	VoteFlowDay        string // 回合
+
+	// 批量模拟报告
+	SimulationTitle    string
+	SimulationGames    string // 有效对局数、失败局数
+	WinRates           string
+	WinRateTableHeader string
+	GameLength         string
+	MeanRounds         string // 均值及置信区间
+	FirstNightKills    string
+	FirstKillHeader    string
+	SeerSurvival       string
+	SurvivalRate       string // 比例及置信区间
+	Potions            string
+	PotionTableHeader  string
+	HealingPotion      string
+	PoisonPotion       string
+	Fallbacks          string
+	FallbackSummary    string // 总次数、每局均值及置信区间
+	Calibration        string
+	CalibrationNote    string
+	CalibrationHeader  string
+	CalibrationCurve   string // 模型
+	CalibrationBins    string
+
+	// 提示词实验报告
+	ExperimentTitle     string // 实验名称
+	ExperimentPairs     string // 有效对数、丢弃对数、对照组、实验组
+	ExperimentMetrics   string
+	ExperimentHeader    string
+	ExperimentWinRate   string // 阵营
+	ExperimentTargetWin string
+	ExperimentSeer      string
+	ExperimentLength    string
+	ExperimentFallbacks string
+	ExperimentNote      string
+}
+
+// TableHeader 返回 Markdown 表格的标题行和分隔行
+func (t *ReportText) TableHeader(header string) string {
+	columns := strings.Count(header, "|") - 1
+	return header + "\n" + strings.Repeat("|------", columns) + "|\n"
+}
 
 // ChineseI18n 中文国际化
 var ChineseI18n = I18nStrings{
+	RoleNames: map[game.Role]string{
+		game.RoleWerewolf:   "狼人",
+		game.RoleHiddenWolf: "隐狼",
+		game.RoleVillager:   "村民",
+		game.RoleSeer:       "预言家",
+		game.RoleWitch:      "女巫",
+		game.RoleHunter:     "猎人",
+	},
+
+	RoleCount:      "%d 个%s",
+	RoleSeparator:  "、",
+	HiddenWolfNote: "（隐狼属于狼人阵营，夜里与狼人一起击杀，被预言家查验时显示为好人）",
+
 	GameStarted:    "=== 🐺 狼人杀游戏开始 🐺 ===",
 	Players:        "玩家",
 	RoleAssignment: "=== 角色分配 ===",
 	Round:          "========== 第 %d 回合 ==========",
 	NightPhase:     "--- 🌙 夜晚阶段 ---",
 	DayPhase:       "--- ☀️ 白天阶段 ---",
-	GameEnded:      "游戏结束：达到最大回合数",
+	GameEnded:      "⚠️ 游戏超过最大回合数，强制结束",
+	Announcement:   "📢 %s",
 
 	WerewolvesDiscussing:  "狼人 (%s) 正在讨论...",
 	WerewolfRound:         "[%s] (狼人第 %d 轮): %s",
-	WerewolvesAgreed:      "✓ 狼人达成一致！",
-	WerewolvesNoAgreement: "⚠️ 狼人未达成明确一致，进入投票...",
-	WerewolvesVoteTo:      "[%s] 投票杀: %s",
+	WerewolfProposal:      "[%s] (狼人第 %d 轮) 提议击杀 %s: %s",
+	WerewolvesAgreed:      "✅ 狼人达成一致！",
+	WerewolvesNoAgreement: "⚠️ 狼人未达成一致",
+	WerewolvesNoTarget:    "⚠️ 狼人没有提出有效的击杀目标，今晚不击杀",
 	WerewolvesDecided:     "➡️ 狼人决定杀: %s (%s)",
+	WerewolvesEmptyKill:   "➡️ 狼人决定空刀，今晚不杀人 (%s)",
 
 	WitchDeciding: "女巫 (%s) 正在决定...",
 	WitchSaved:    "➡️ 女巫救了 %s！",
@@ -112,42 +317,359 @@ var ChineseI18n = I18nStrings{
 
 	AlivePlayers:    "📢 存活玩家: %s",
 	DiscussionPhase: "💬 讨论阶段:",
+	DiscussionRound: "💬 第 %d 轮讨论 (%s)",
 	PlayerSpeaks:    "[%s]: %s",
 	VotingPhase:     "🗳️ 投票阶段:",
 	PlayerVotes:     "[%s] 投票: %s",
-	NoValidVotes:    "无有效投票。",
+	PlayerAbstains:  "[%s] 弃票",
+	PlayerSpoiled:   "[%s] 废票（%s）",
+	NoValidVotes:    "无有效投票",
+	VoteNoQuorum:    "有效票 %d 张（存活 %d 人），未达到淘汰所需的 %d 张，本轮无人被淘汰 (%s)",
 	VoteResult:      "➡️ 投票结果: %s 被淘汰 (%s)",
 
+	NoSheriff:        "⚠️ 没有存活的警长，按顺时针发言",
+	SheriffDirection: "👮 警长 %s 选择%s发言",
+	Clockwise:        "顺时针",
+	CounterClockwise: "逆时针",
+	RebuttalPhase:    "🗣️ 反驳环节:",
+	Rebuttal:         "[%s] (反驳): %s",
+
 	LastWords: "[%s] (遗言): %s",
 
 	HunterShot: "🔫 猎人射杀了 %s！",
 
-	WerewolvesWin: "🐺🐺🐺 狼人获胜！🐺🐺🐺",
-	VillagersWin:  "🏘️🏘️🏘️ 村民获胜！🏘️🏘️🏘️",
+	TargetRejected: "⚠️ %s 多次选择不合法的目标（%s），本次行动作废",
+
+	WerewolvesWin: "🐺 狼人阵营获胜！",
+	VillagersWin:  "👨‍🌾 好人阵营获胜！",
 	Roles:         "角色",
+	FinalRoles:    "=== 最终角色揭示 ===",
+	StatusAlive:   "存活",
+	StatusDead:    "死亡",
 
-	PlayerReflections: "=== 玩家反思 ===",
+	PlayerReflections: "=== 🎭 玩家反思 ===",
 	Reflection:        "[%s] 反思: %s",
 
-	Error: "[%s] 错误: %v",
+	ModDeaths:       "昨晚 %s 被淘汰了。",
+	ModPeace:        "昨晚是平安夜，没有人被淘汰。",
+	ModDiscussStart: "现在进入讨论阶段，请各位玩家依次发言。",
+	ModVoteStart:    "讨论结束，现在进入投票阶段，请投票选出你认为的狼人。",
+	ModNightFall:    "天黑了，请所有人闭眼。",
+	ModWolvesWake:   "狼人请睁眼，请选择今晚要击杀的玩家。",
+	ModWitchWake:    "女巫请睁眼。",
+	ModSeerWake:     "预言家请睁眼，请选择要查验的玩家。",
+	ModDawn:         "天亮了，请所有人睁眼。",
+	ModSheriff:      "警长 %s 选择%s发言。",
+
+	PhaseNight:      "🌙 夜间阶段",
+	PhaseDay:        "☀️ 白天阶段",
+	PhaseDiscussion: "💬 讨论阶段",
+	PhaseRebuttal:   "🗣️ 反驳环节",
+	PhaseVote:       "🗳️ 投票阶段",
+
+	Error: "⚠️ [%s] 调用错误: %v",
+
+	ClaimPhrases: []string{"我是%s", "我是真%s", "本人是%s", "我的身份是%s"},
+
+	LessonPrefixes: []string{"经验：", "经验:"},
+
+	ClaimRole:     "声称是%s",
+	ClaimCheck:    "查验 %s 为%s",
+	ClaimGood:     "好人",
+	ClaimSuspects: "怀疑 %s",
+	ClaimTrusted:  "信任 %s",
+	CounterClaim:  "⚠️ %s 对跳%s",
+	ClaimsSummary: "📋 公开声明汇总:\n%s",
+
+	Commentary: "🎙️ [解说] %s",
+
+	GameSummary:        "\n游戏 %s 结束：胜利方 %s，共 %d 回合",
+	SimulationProgress: "模拟进度: %d/%d 局完成",
+	ExperimentProgress: "实验进度: %d/%d 局完成",
+
+	Tools: ToolText{
+		Discuss: ToolSpec{
+			Desc: "狼人内部讨论工具，用于与其他狼人交流并提议今晚的击杀目标",
+			Params: map[string]string{
+				"target":  "你提议今晚击杀的玩家名；本局允许空刀时填 none；只想发言不提议时留空",
+				"message": "你想对其他狼人说的话，包括提议的理由",
+			},
+		},
+		Kill: ToolSpec{
+			Desc:   "狼人击杀工具，用于选择今晚要击杀的玩家",
+			Params: map[string]string{"target": "要击杀的玩家名"},
+		},
+		Check: ToolSpec{
+			Desc:   "预言家查验工具，用于查验一名玩家的身份",
+			Params: map[string]string{"target": "要查验的玩家名"},
+		},
+		Save: ToolSpec{
+			Desc:   "女巫救人工具，用于使用解药救活被狼人击杀的玩家",
+			Params: map[string]string{"save": "是否使用解药救人"},
+		},
+		Poison: ToolSpec{
+			Desc: "女巫毒人工具，用于使用毒药毒杀一名玩家",
+			Params: map[string]string{
+				"poison": "是否使用毒药",
+				"target": "要毒杀的玩家名（如果使用毒药）",
+			},
+		},
+		Shoot: ToolSpec{
+			Desc: "猎人开枪工具，被淘汰时可以开枪带走一名玩家",
+			Params: map[string]string{
+				"shoot":  "是否开枪",
+				"target": "要射杀的玩家名（如果开枪）",
+			},
+		},
+		Vote: ToolSpec{
+			Desc: "投票工具，用于在白天投票淘汰玩家，也可以弃票",
+			Params: map[string]string{
+				"target":  "投票淘汰的玩家名，弃票时留空",
+				"abstain": "是否弃票（不投给任何人）",
+			},
+		},
+		Speech: ToolSpec{
+			Desc: "结构化发言工具（可选），白天发言时用于公开声明身份、查验结果、怀疑和信任的玩家",
+			Params: map[string]string{
+				"claim_role": "公开声称的身份，可选值：werewolf、villager、seer、witch、hunter；不声明身份时留空",
+				"checks":     "公开声称的查验结果",
+				"target":     "声称查验过的玩家名",
+				"is_wolf":    "声称的查验结果是否为狼人",
+				"suspects":   "你怀疑是狼人的玩家名",
+				"trusted":    "你信任的玩家名",
+			},
+		},
+		Belief: ToolSpec{
+			Desc:   "概率判断工具（可选），提交你认为其他每名玩家是狼人的概率",
+			Params: map[string]string{"probs": "其他每名存活玩家是狼人的概率，键为玩家名，值为 0 到 1 之间的小数"},
+		},
+		Direction: ToolSpec{
+			Desc:   "发言方向工具，警长用于决定今天的发言方向",
+			Params: map[string]string{"direction": "发言方向：clockwise（顺时针）或 counterclockwise（逆时针）"},
+		},
+
+		Killed:          "决定击杀 %s",
+		NoNightKill:     "今晚没有人被狼人击杀",
+		Saved:           "使用解药救活了 %s",
+		NoSave:          "选择不使用解药",
+		NoPoisonLeft:    "毒药已用完",
+		NoPoison:        "选择不使用毒药",
+		NoPoisonTarget:  "请指定毒杀目标",
+		Poisoned:        "使用毒药毒杀了 %s",
+		NoShot:          "选择不开枪",
+		NoShootTarget:   "请指定射杀目标",
+		Shot:            "猎人开枪射杀了 %s",
+		Abstained:       "选择弃票",
+		Voted:           "投票淘汰 %s",
+		SpeechRecorded:  "已记录结构化发言，请继续给出你的完整发言",
+		NoBeliefs:       "没有有效的概率，请以玩家名为键、0 到 1 之间的小数为值重新提交",
+		BeliefsRecorded: "已记录对 %d 名玩家的判断",
+		BadDirection:    "发言方向只能是 clockwise 或 counterclockwise",
+		DirectionChosen: "今天的发言方向：%s",
+	},
+
+	Log: game.LogText{
+		RoleNames: map[game.Role]string{
+			game.RoleWerewolf:   "狼人",
+			game.RoleHiddenWolf: "隐狼",
+			game.RoleVillager:   "村民",
+			game.RoleSeer:       "预言家",
+			game.RoleWitch:      "女巫",
+			game.RoleHunter:     "猎人",
+		},
+		FactionNames: map[game.Faction]string{
+			game.FactionWerewolf: "狼人阵营",
+			game.FactionVillager: "好人阵营",
+		},
+
+		FullTitle:            "# 🐺 狼人杀游戏完整日志",
+		ReplayTitle:          "# 🎮 狼人杀游戏回放",
+		GameID:               "**游戏ID**: %s",
+		StartTime:            "**开始时间**: %s",
+		RoleAssignment:       "## 📋 角色分配",
+		RoleTableHeader:      "| 玩家 | 角色 |",
+		ReplayRoleAssignment: "## 角色分配",
+
+		Round:       "## 🔄 第 %d 回合",
+		ReplayRound: "## 第 %d 回合",
+		Moderator:   "🎭 **主持人**: %s",
+
+		WolfConspiracy:       "### 🤝 狼人密谋",
+		ReplayWolfConspiracy: "🤝 狼人密谋 (%s)",
+		WolfIndividualVote:   "- **%s** 最终提议: %s",
+		WolfKill:             "**狼人决定击杀**: %s (%s)",
+		ReplayWolfKill:       "🐺 狼人击杀: %s",
+		WolfNoKill:           "**狼人决定空刀**: (%s)",
+		ReplayWolfNoKill:     "🐺 狼人空刀",
+
+		SeerCheck:         "**预言家查验**: %s → %s",
+		ReplaySeerCheck:   "🔮 预言家查验 %s: %s",
+		WitchSave:         "**女巫使用解药**: 救活 %s",
+		ReplayWitchSave:   "💊 女巫救活: %s",
+		WitchPoison:       "**女巫使用毒药**: 毒杀 %s",
+		ReplayWitchPoison: "☠️ 女巫毒杀: %s",
+
+		NightSummary:     "**夜晚结算**:",
+		NightKilledSaved: "- 狼人击杀 %s，被女巫救活",
+		NightKilled:      "- 狼人击杀 %s",
+		NightPoisoned:    "- 女巫毒杀 %s",
+		NightShot:        "- 猎人射杀 %s",
+
+		VoteResult:      "**投票结果**: %s 被淘汰 (%s)",
+		VoteResultNone:  "**投票结果**: %s",
+		VoteAbstain:     "- %s 弃票",
+		VoteSpoiled:     "- %s 废票（%s）",
+		ReplayVoteOut:   "🗳️ 投票淘汰: %s",
+		LastWords:       "**[%s 遗言]**: %s",
+		ReplayLastWords: "💀 %s 遗言: %s",
+
+		HunterShoot:       "**猎人开枪**: 射杀 %s",
+		ReplayHunterShoot: "🔫 猎人射杀: %s",
+
+		GameOver:        "## 🏆 游戏结束",
+		Winner:          "**胜利者**: %s",
+		Survivors:       "**存活玩家**: %s",
+		Duration:        "**游戏时长**: %s",
+		ReplayWinner:    "## 🏆 %s 获胜！",
+		ReplaySurvivors: "存活: %s",
+
+		ReflectionPrefixes: []string{"反思:", "反思："},
+
+		CommentaryTitle: "# 🎙️ 解说记录",
+		CommentaryPhase: "## 第 %d 回合 %s",
+
+		ReplaySetup:        "━━ 开局 ━━",
+		ReplayStep:         "━━ 第 %d 回合 · %s ━━",
+		ReplayGodView:      "👁️ 上帝视角",
+		ReplaySeatView:     "👁️ %s 的视角（%s）",
+		ReplayModerator:    "🎭 %s",
+		ReplaySpeech:       "🗣️ %s: %s",
+		ReplayWolfSpeech:   "🐺 %s: %s",
+		ReplayWolfVote:     "🐺 %s 最终提议击杀 %s",
+		ReplayWolfProposal: "🐺 %s（提议击杀 %s）: %s",
+		ReplayVote:         "🗳️ %s 投票给 %s",
+		ReplayAbstain:      "🗳️ %s 弃票",
+		ReplaySpoiled:      "🗳️ %s 的选票作废（%s）",
+		ReplayVoteResult:   "🗳️ 投票结果: %s",
+		ReplayDeath:        "💀 %s 出局（%s）",
+		ReplayDeathUnknown: "💀 %s 出局",
+		ReplayBelief:       "🎯 %s 的概率判断: %s",
+		ReplayReflection:   "💭 %s: %s",
+		ReplayCommentary:   "🎙️ %s",
+		ReplayHelp:         "[回车] 下一阶段  [b] 上一阶段  [r N] 跳到第 N 回合  [q] 退出",
+		DeathCauses: map[string]string{
+			game.DeathKilled:   "被狼人击杀",
+			game.DeathPoisoned: "被女巫毒杀",
+			game.DeathShot:     "被猎人射杀",
+			game.DeathVoted:    "被投票放逐",
+		},
+		SpoiledReasons: map[string]string{
+			string(game.ViolationMissing): "没有给出目标",
+			string(game.ViolationUnknown): "目标不在本局游戏中",
+			string(game.ViolationDead):    "目标已经出局",
+			string(game.ViolationSelf):    "投给了自己",
+			game.SpoiledNoResponse:        "没有回应",
+		},
+
+		TranscriptTitle:      "# 👁️ %s 的视角（%s）",
+		TranscriptSystem:     "### ⚙️ 系统提示",
+		TranscriptUser:       "### 🎭 主持人",
+		TranscriptAssistant:  "### 💬 %s",
+		TranscriptToolCall:   "🔧 调用 `%s`: `%s`",
+		TranscriptToolResult: "### 🔧 工具结果（%s）",
+
+		Saved: "日志已保存到: %s",
+	},
+
+	Report: ReportText{
+		None: "无",
+
+		AnalysisTitle:      "# 📊 赛后分析",
+		AnalysisSummary:    "**胜利阵营**: %s，**回合数**: %d，**好人投票命中率**: %.1f%%",
+		PlayerMetrics:      "## 👥 玩家指标",
+		PlayerTableHeader:  "| 玩家 | 角色 | 出局 | 发言 | 投票 | 得票 | 投中狼人 | 命中率 | 怀疑准确率 | 伪装分 | 身份声明 |",
+		SeerChecks:         "## 🔮 预言家查验",
+		NoChecks:           "无查验记录",
+		ChecksTableHeader:  "| 回合 | 目标 | 结果 | 之后好人投票 | 是否被采纳 |",
+		ClaimedChecks:      "## 📋 声称的查验结果",
+		ClaimedTableHeader: "| 回合 | 声称者 | 目标 | 声称结果 | 是否虚假 |",
+		FakeCheck:          "❌ 虚假",
+		CounterClaims:      "## ⚔️ 对跳",
+		FalseClaims:        "## 🎭 虚假身份声明",
+		FalseClaim:         "- 第 %d 天 %s（%s）声称自己是 %s",
+		Beliefs:            "## 🎯 概率判断",
+		BeliefSummary:      "好人阵营整体: %d 条判断，Brier %.3f，对数损失 %.3f（始终猜 0.5 时为 0.250 / 0.693）",
+		BeliefTableHeader:  "| 玩家 | 角色 | 样本数 | Brier | 对数损失 |",
+		VoteFlow:           "## 🗳️ 投票流向",
+		VoteFlowDay:        "### 第 %d 天",
+
+		SimulationTitle:    "# 📊 狼人杀批量模拟报告",
+		SimulationGames:    "**有效对局**: %d (失败 %d)",
+		WinRates:           "## 🏆 胜率",
+		WinRateTableHeader: "| 阵营 | 局数 | 胜率 | 95% CI |",
+		GameLength:         "## ⏱️ 游戏长度",
+		MeanRounds:         "平均回合数: %s",
+		FirstNightKills:    "## 🐺 首夜击杀分布",
+		FirstKillHeader:    "| 角色 | 局数 | 比例 | 95% CI |",
+		SeerSurvival:       "## 🔮 预言家存活",
+		SurvivalRate:       "存活率: %s",
+		Potions:            "## 🧙‍♀️ 药水使用",
+		PotionTableHeader:  "| 药水 | 使用率 | 平均使用回合 |",
+		HealingPotion:      "解药",
+		PoisonPotion:       "毒药",
+		Fallbacks:          "## ⚠️ 回退统计",
+		FallbackSummary:    "总回退次数: %d，每局平均: %s",
+		Calibration:        "## 🎯 概率判断校准（好人阵营）",
+		CalibrationNote:    "Brier 分数和对数损失越低越好；始终猜 0.5 时分别为 0.250 和 0.693。",
+		CalibrationHeader:  "| 模型 | 样本数 | Brier | 对数损失 |",
+		CalibrationCurve:   "**%s 校准曲线**",
+		CalibrationBins:    "| 预测区间 | 样本数 | 平均预测 | 实际为狼比例 |",
+
+		ExperimentTitle:     "# 🧪 提示词实验报告: %s",
+		ExperimentPairs:     "**有效对数**: %d (丢弃 %d)，对照组: `%s`，实验组: `%s`",
+		ExperimentMetrics:   "## 📈 指标对比",
+		ExperimentHeader:    "| 指标 | 对数 | 对照组 | 实验组 | 差值 | 95% CI | p 值 |",
+		ExperimentWinRate:   "%s胜率",
+		ExperimentTargetWin: "变体玩家阵营胜率",
+		ExperimentSeer:      "预言家存活率",
+		ExperimentLength:    "平均回合数",
+		ExperimentFallbacks: "每局回退次数",
+		ExperimentNote:      "比例指标使用 McNemar 精确检验，数值指标使用配对差值的正态近似检验。同一对的两局使用相同种子，因此角色分配相同，之后的对局过程可能不同。",
+	},
 }
 
 // EnglishI18n 英文国际化
 var EnglishI18n = I18nStrings{
+	RoleNames: map[game.Role]string{
+		game.RoleWerewolf:   "Werewolf",
+		game.RoleHiddenWolf: "Hidden Wolf",
+		game.RoleVillager:   "Villager",
+		game.RoleSeer:       "Seer",
+		game.RoleWitch:      "Witch",
+		game.RoleHunter:     "Hunter",
+	},
+
+	RoleCount:      "%d × %s",
+	RoleSeparator:  ", ",
+	HiddenWolfNote: " (the Hidden Wolf belongs to the werewolf camp and kills with the werewolves at night, but appears good when checked by the seer)",
+
 	GameStarted:    "=== 🐺 Werewolf Game Started 🐺 ===",
 	Players:        "Players",
 	RoleAssignment: "=== Role Assignment ===",
 	Round:          "========== Round %d ==========",
 	NightPhase:     "--- 🌙 Night Phase ---",
 	DayPhase:       "--- ☀️ Day Phase ---",
-	GameEnded:      "Game ended: Maximum rounds reached",
+	GameEnded:      "⚠️ Game ended: maximum rounds reached",
+	Announcement:   "📢 %s",
 
 	WerewolvesDiscussing:  "Werewolves (%s) are discussing...",
 	WerewolfRound:         "[%s] (Wolf round %d): %s",
-	WerewolvesAgreed:      "✓ Werewolves reached agreement!",
-	WerewolvesNoAgreement: "⚠️ Werewolves did not reach clear agreement, proceeding to vote...",
-	WerewolvesVoteTo:      "[%s] votes to kill: %s",
+	WerewolfProposal:      "[%s] (Wolf round %d) proposes to kill %s: %s",
+	WerewolvesAgreed:      "✅ Werewolves reached agreement!",
+	WerewolvesNoAgreement: "⚠️ Werewolves did not reach agreement",
+	WerewolvesNoTarget:    "⚠️ Werewolves proposed no valid target, nobody is killed tonight",
 	WerewolvesDecided:     "➡️ Werewolves decided to kill: %s (%s)",
+	WerewolvesEmptyKill:   "➡️ Werewolves chose an empty kill, nobody is attacked tonight (%s)",
 
 	WitchDeciding: "Witch (%s) is deciding...",
 	WitchSaved:    "➡️ Witch saved %s!",
@@ -163,33 +685,691 @@ var EnglishI18n = I18nStrings{
 
 	AlivePlayers:    "📢 Alive players: %s",
 	DiscussionPhase: "💬 Discussion phase:",
+	DiscussionRound: "💬 Discussion round %d (%s)",
 	PlayerSpeaks:    "[%s]: %s",
 	VotingPhase:     "🗳️ Voting phase:",
 	PlayerVotes:     "[%s] votes: %s",
-	NoValidVotes:    "No valid votes.",
+	PlayerAbstains:  "[%s] abstains",
+	PlayerSpoiled:   "[%s] spoiled ballot (%s)",
+	NoValidVotes:    "No valid votes",
+	VoteNoQuorum:    "%d valid votes (%d alive), below the %d required for an elimination; nobody is eliminated (%s)",
 	VoteResult:      "➡️ Vote result: %s eliminated (%s)",
 
+	NoSheriff:        "⚠️ No sheriff alive, speaking clockwise",
+	SheriffDirection: "👮 Sheriff %s chose to speak %s",
+	Clockwise:        "clockwise",
+	CounterClockwise: "counterclockwise",
+	RebuttalPhase:    "🗣️ Rebuttal:",
+	Rebuttal:         "[%s] (Rebuttal): %s",
+
 	LastWords: "[%s] (Last words): %s",
 
 	HunterShot: "🔫 Hunter shot %s!",
 
-	WerewolvesWin: "🐺🐺🐺 Werewolves Win! 🐺🐺🐺",
-	VillagersWin:  "🏘️🏘️🏘️ Villagers Win! 🏘️🏘️🏘️",
+	TargetRejected: "⚠️ %s repeatedly chose an invalid target (%s); the action is void",
+
+	WerewolvesWin: "🐺 Werewolves win!",
+	VillagersWin:  "👨‍🌾 Villagers win!",
 	Roles:         "Roles",
+	FinalRoles:    "=== Final Roles ===",
+	StatusAlive:   "alive",
+	StatusDead:    "dead",
 
-	PlayerReflections: "=== Player Reflections ===",
+	PlayerReflections: "=== 🎭 Player Reflections ===",
 	Reflection:        "[%s] Reflection: %s",
 
-	Error: "[%s] Error: %v",
-}
+	ModDeaths:       "Last night %s was eliminated.",
+	ModPeace:        "Last night was peaceful, no one was eliminated.",
+	ModDiscussStart: "The discussion phase begins, please speak in turn.",
+	ModVoteStart:    "The discussion is over, please vote for the player you believe is a werewolf.",
+	ModNightFall:    "Night has fallen, everyone close your eyes.",
+	ModWolvesWake:   "Werewolves, open your eyes and choose a player to kill tonight.",
+	ModWitchWake:    "Witch, open your eyes.",
+	ModSeerWake:     "Seer, open your eyes and choose a player to check.",
+	ModDawn:         "The day is breaking, everyone open your eyes.",
+	ModSheriff:      "Sheriff %s chose to speak %s.",
+
+	PhaseNight:      "🌙 Night Phase",
+	PhaseDay:        "☀️ Day Phase",
+	PhaseDiscussion: "💬 Discussion",
+	PhaseRebuttal:   "🗣️ Rebuttal",
+	PhaseVote:       "🗳️ Voting",
+
+	Error: "⚠️ [%s] Error: %v",
+
+	ClaimPhrases: []string{"I am the %s", "I'm the %s", "I am a %s", "I'm a %s", "my role is %s"},
 
-// UseChinese 切换到中文模式
-func UseChinese() {
-	Prompts = ChinesePrompts
-	I18n = ChineseI18n
+	LessonPrefixes: []string{"Lesson:"},
+
+	ClaimRole:     "claims to be %s",
+	ClaimCheck:    "checked %s as %s",
+	ClaimGood:     "good",
+	ClaimSuspects: "suspects %s",
+	ClaimTrusted:  "trusts %s",
+	CounterClaim:  "⚠️ %s counter-claim %s",
+	ClaimsSummary: "📋 Public claims:\n%s",
+
+	Commentary: "🎙️ [Commentary] %s",
+
+	GameSummary:        "\nGame %s over: winner %s, %d rounds",
+	SimulationProgress: "Simulation progress: %d/%d games done",
+	ExperimentProgress: "Experiment progress: %d/%d games done",
+
+	Tools: ToolText{
+		Discuss: ToolSpec{
+			Desc: "Werewolf-only discussion tool: talk with the other werewolves and propose tonight's kill target",
+			Params: map[string]string{
+				"target":  "Name of the player you propose to kill tonight; use none when this board allows an empty kill; leave empty to talk without proposing",
+				"message": "What you want to tell the other werewolves, including the reason for your proposal",
+			},
+		},
+		Kill: ToolSpec{
+			Desc:   "Werewolf kill tool: choose the player to kill tonight",
+			Params: map[string]string{"target": "Name of the player to kill"},
+		},
+		Check: ToolSpec{
+			Desc:   "Seer check tool: check one player's identity",
+			Params: map[string]string{"target": "Name of the player to check"},
+		},
+		Save: ToolSpec{
+			Desc:   "Witch save tool: use the healing potion on the player killed by the werewolves",
+			Params: map[string]string{"save": "Whether to use the healing potion"},
+		},
+		Poison: ToolSpec{
+			Desc: "Witch poison tool: use the poison on one player",
+			Params: map[string]string{
+				"poison": "Whether to use the poison",
+				"target": "Name of the player to poison (if using the poison)",
+			},
+		},
+		Shoot: ToolSpec{
+			Desc: "Hunter shoot tool: when eliminated, you may shoot one player and take them with you",
+			Params: map[string]string{
+				"shoot":  "Whether to shoot",
+				"target": "Name of the player to shoot (if shooting)",
+			},
+		},
+		Vote: ToolSpec{
+			Desc: "Vote tool: vote to eliminate a player during the day, or abstain",
+			Params: map[string]string{
+				"target":  "Name of the player you vote to eliminate; leave empty to abstain",
+				"abstain": "Whether to abstain (vote for nobody)",
+			},
+		},
+		Speech: ToolSpec{
+			Desc: "Structured speech tool (optional): during the day, publicly state your claimed role, check results, suspects and trusted players",
+			Params: map[string]string{
+				"claim_role": "Role you publicly claim, one of werewolf, villager, seer, witch, hunter; leave empty if you claim no role",
+				"checks":     "Check results you publicly claim",
+				"target":     "Name of the player you claim to have checked",
+				"is_wolf":    "Whether the claimed check result is werewolf",
+				"suspects":   "Names of the players you suspect are werewolves",
+				"trusted":    "Names of the players you trust",
+			},
+		},
+		Belief: ToolSpec{
+			Desc:   "Belief tool (optional): submit the probability that each other player is a werewolf",
+			Params: map[string]string{"probs": "Probability that each other living player is a werewolf, keyed by player name, as a number between 0 and 1"},
+		},
+		Direction: ToolSpec{
+			Desc:   "Speaking direction tool: the sheriff decides today's speaking direction",
+			Params: map[string]string{"direction": "Speaking direction: clockwise or counterclockwise"},
+		},
+
+		Killed:          "Decided to kill %s",
+		NoNightKill:     "Nobody was killed by the werewolves tonight",
+		Saved:           "Used the healing potion to save %s",
+		NoSave:          "Chose not to use the healing potion",
+		NoPoisonLeft:    "The poison has already been used",
+		NoPoison:        "Chose not to use the poison",
+		NoPoisonTarget:  "Please name the player to poison",
+		Poisoned:        "Used the poison on %s",
+		NoShot:          "Chose not to shoot",
+		NoShootTarget:   "Please name the player to shoot",
+		Shot:            "The hunter shot %s",
+		Abstained:       "Chose to abstain",
+		Voted:           "Voted to eliminate %s",
+		SpeechRecorded:  "Structured speech recorded; please go on with your full speech",
+		NoBeliefs:       "No valid probabilities; resubmit with player names as keys and numbers between 0 and 1 as values",
+		BeliefsRecorded: "Recorded beliefs about %d players",
+		BadDirection:    "The speaking direction must be clockwise or counterclockwise",
+		DirectionChosen: "Today's speaking direction: %s",
+	},
+
+	Log: game.LogText{
+		RoleNames: map[game.Role]string{
+			game.RoleWerewolf:   "Werewolf",
+			game.RoleHiddenWolf: "Hidden Wolf",
+			game.RoleVillager:   "Villager",
+			game.RoleSeer:       "Seer",
+			game.RoleWitch:      "Witch",
+			game.RoleHunter:     "Hunter",
+		},
+		FactionNames: map[game.Faction]string{
+			game.FactionWerewolf: "Werewolves",
+			game.FactionVillager: "Villagers",
+		},
+
+		FullTitle:            "# 🐺 Werewolf Game Full Log",
+		ReplayTitle:          "# 🎮 Werewolf Game Replay",
+		GameID:               "**Game ID**: %s",
+		StartTime:            "**Start Time**: %s",
+		RoleAssignment:       "## 📋 Role Assignment",
+		RoleTableHeader:      "| Player | Role |",
+		ReplayRoleAssignment: "## Role Assignment",
+
+		Round:       "## 🔄 Round %d",
+		ReplayRound: "## Round %d",
+		Moderator:   "🎭 **Moderator**: %s",
+
+		WolfConspiracy:       "### 🤝 Werewolf Conspiracy",
+		ReplayWolfConspiracy: "🤝 Werewolf conspiracy (%s)",
+		WolfIndividualVote:   "- **%s** final proposal: %s",
+		WolfKill:             "**Werewolves decided to kill**: %s (%s)",
+		ReplayWolfKill:       "🐺 Werewolves killed: %s",
+		WolfNoKill:           "**Werewolves chose an empty kill**: (%s)",
+		ReplayWolfNoKill:     "🐺 Werewolves attacked nobody",
+
+		SeerCheck:         "**Seer check**: %s → %s",
+		ReplaySeerCheck:   "🔮 Seer checked %s: %s",
+		WitchSave:         "**Witch used the healing potion**: saved %s",
+		ReplayWitchSave:   "💊 Witch saved: %s",
+		WitchPoison:       "**Witch used the poison**: poisoned %s",
+		ReplayWitchPoison: "☠️ Witch poisoned: %s",
+
+		NightSummary:     "**Night summary**:",
+		NightKilledSaved: "- Werewolves killed %s, saved by the witch",
+		NightKilled:      "- Werewolves killed %s",
+		NightPoisoned:    "- Witch poisoned %s",
+		NightShot:        "- Hunter shot %s",
+
+		VoteResult:      "**Vote result**: %s eliminated (%s)",
+		VoteResultNone:  "**Vote result**: %s",
+		VoteAbstain:     "- %s abstains",
+		VoteSpoiled:     "- %s spoiled ballot (%s)",
+		ReplayVoteOut:   "🗳️ Voted out: %s",
+		LastWords:       "**[%s last words]**: %s",
+		ReplayLastWords: "💀 %s last words: %s",
+
+		HunterShoot:       "**Hunter fired**: shot %s",
+		ReplayHunterShoot: "🔫 Hunter shot: %s",
+
+		GameOver:        "## 🏆 Game Over",
+		Winner:          "**Winner**: %s",
+		Survivors:       "**Survivors**: %s",
+		Duration:        "**Duration**: %s",
+		ReplayWinner:    "## 🏆 %s win!",
+		ReplaySurvivors: "Survivors: %s",
+
+		ReflectionPrefixes: []string{"Reflection:"},
+
+		CommentaryTitle: "# 🎙️ Commentary",
+		CommentaryPhase: "## Round %d %s",
+
+		ReplaySetup:        "━━ Setup ━━",
+		ReplayStep:         "━━ Round %d · %s ━━",
+		ReplayGodView:      "👁️ God view",
+		ReplaySeatView:     "👁️ %s's view (%s)",
+		ReplayModerator:    "🎭 %s",
+		ReplaySpeech:       "🗣️ %s: %s",
+		ReplayWolfSpeech:   "🐺 %s: %s",
+		ReplayWolfVote:     "🐺 %s's final proposal is to kill %s",
+		ReplayWolfProposal: "🐺 %s (proposes %s): %s",
+		ReplayVote:         "🗳️ %s votes for %s",
+		ReplayAbstain:      "🗳️ %s abstains",
+		ReplaySpoiled:      "🗳️ %s's ballot is spoiled (%s)",
+		ReplayVoteResult:   "🗳️ Vote result: %s",
+		ReplayDeath:        "💀 %s is out (%s)",
+		ReplayDeathUnknown: "💀 %s is out",
+		ReplayBelief:       "🎯 %s's beliefs: %s",
+		ReplayReflection:   "💭 %s: %s",
+		ReplayCommentary:   "🎙️ %s",
+		ReplayHelp:         "[Enter] next phase  [b] previous  [r N] jump to round N  [q] quit",
+		DeathCauses: map[string]string{
+			game.DeathKilled:   "killed by werewolves",
+			game.DeathPoisoned: "poisoned by the witch",
+			game.DeathShot:     "shot by the hunter",
+			game.DeathVoted:    "voted out",
+		},
+		SpoiledReasons: map[string]string{
+			string(game.ViolationMissing): "no target given",
+			string(game.ViolationUnknown): "target is not in this game",
+			string(game.ViolationDead):    "target already eliminated",
+			string(game.ViolationSelf):    "voted for themselves",
+			game.SpoiledNoResponse:        "no response",
+		},
+
+		TranscriptTitle:      "# 👁️ %s's view (%s)",
+		TranscriptSystem:     "### ⚙️ System prompt",
+		TranscriptUser:       "### 🎭 Moderator",
+		TranscriptAssistant:  "### 💬 %s",
+		TranscriptToolCall:   "🔧 Call `%s`: `%s`",
+		TranscriptToolResult: "### 🔧 Tool result (%s)",
+
+		Saved: "Logs saved to: %s",
+	},
+
+	Report: ReportText{
+		None: "none",
+
+		AnalysisTitle:      "# 📊 Post-game Analysis",
+		AnalysisSummary:    "**Winner**: %s, **Rounds**: %d, **Villager vote accuracy**: %.1f%%",
+		PlayerMetrics:      "## 👥 Player Metrics",
+		PlayerTableHeader:  "| Player | Role | Out | Speeches | Votes | Received | Votes on wolves | Accuracy | Suspect accuracy | Deception | Claims |",
+		SeerChecks:         "## 🔮 Seer Checks",
+		NoChecks:           "No checks recorded",
+		ChecksTableHeader:  "| Round | Target | Result | Villager votes after | Acted upon |",
+		ClaimedChecks:      "## 📋 Claimed Checks",
+		ClaimedTableHeader: "| Round | Claimant | Target | Claimed result | Fake |",
+		FakeCheck:          "❌ fake",
+		CounterClaims:      "## ⚔️ Counter-claims",
+		FalseClaims:        "## 🎭 False Role Claims",
+		FalseClaim:         "- Day %d: %s (%s) claimed to be %s",
+		Beliefs:            "## 🎯 Beliefs",
+		BeliefSummary:      "Village overall: %d judgements, Brier %.3f, log loss %.3f (always guessing 0.5 gives 0.250 / 0.693)",
+		BeliefTableHeader:  "| Player | Role | Samples | Brier | Log loss |",
+		VoteFlow:           "## 🗳️ Vote Flow",
+		VoteFlowDay:        "### Day %d",
+
+		SimulationTitle:    "# 📊 Werewolf Simulation Report",
+		SimulationGames:    "**Completed games**: %d (failed %d)",
+		WinRates:           "## 🏆 Win Rates",
+		WinRateTableHeader: "| Faction | Games | Win rate | 95% CI |",
+		GameLength:         "## ⏱️ Game Length",
+		MeanRounds:         "Mean rounds: %s",
+		FirstNightKills:    "## 🐺 First-night Kills",
+		FirstKillHeader:    "| Role | Games | Share | 95% CI |",
+		SeerSurvival:       "## 🔮 Seer Survival",
+		SurvivalRate:       "Survival rate: %s",
+		Potions:            "## 🧙‍♀️ Potion Use",
+		PotionTableHeader:  "| Potion | Use rate | Mean round used |",
+		HealingPotion:      "Healing",
+		PoisonPotion:       "Poison",
+		Fallbacks:          "## ⚠️ Fallbacks",
+		FallbackSummary:    "Total fallbacks: %d, per game: %s",
+		Calibration:        "## 🎯 Belief Calibration (village)",
+		CalibrationNote:    "Lower Brier score and log loss are better; always guessing 0.5 gives 0.250 and 0.693.",
+		CalibrationHeader:  "| Model | Samples | Brier | Log loss |",
+		CalibrationCurve:   "**%s calibration curve**",
+		CalibrationBins:    "| Predicted range | Samples | Mean predicted | Observed wolf rate |",
+
+		ExperimentTitle:     "# 🧪 Prompt Experiment Report: %s",
+		ExperimentPairs:     "**Completed pairs**: %d (dropped %d), control: `%s`, treatment: `%s`",
+		ExperimentMetrics:   "## 📈 Metrics",
+		ExperimentHeader:    "| Metric | Pairs | Control | Treatment | Delta | 95% CI | p-value |",
+		ExperimentWinRate:   "%s win rate",
+		ExperimentTargetWin: "Variant players' faction win rate",
+		ExperimentSeer:      "Seer survival rate",
+		ExperimentLength:    "Mean rounds",
+		ExperimentFallbacks: "Fallbacks per game",
+		ExperimentNote:      "Proportions use McNemar's exact test; numeric metrics use a normal approximation on paired differences. Both games in a pair share the same seed and therefore the same role assignment; later play may diverge.",
+	},
 }
 
-// UseEnglish 使用英文
-func UseEnglish() {
-	I18n = EnglishI18n
+// JapaneseI18n 日文国际化
+var JapaneseI18n = I18nStrings{
+	RoleNames: map[game.Role]string{
+		game.RoleWerewolf:   "人狼",
+		game.RoleHiddenWolf: "隠れ狼",
+		game.RoleVillager:   "村人",
+		game.RoleSeer:       "占い師",
+		game.RoleWitch:      "魔女",
+		game.RoleHunter:     "狩人",
+	},
+
+	RoleCount:      "%[2]s%[1]d人",
+	RoleSeparator:  "、",
+	HiddenWolfNote: "（隠れ狼は人狼陣営に属し、夜は人狼と一緒に襲撃しますが、占い師に占われると村人陣営と判定されます）",
+
+	GameStarted:    "=== 🐺 人狼ゲーム開始 🐺 ===",
+	Players:        "プレイヤー",
+	RoleAssignment: "=== 役職配布 ===",
+	Round:          "========== 第 %d ラウンド ==========",
+	NightPhase:     "--- 🌙 夜フェーズ ---",
+	DayPhase:       "--- ☀️ 昼フェーズ ---",
+	GameEnded:      "⚠️ 最大ラウンド数に達したため、ゲームを終了します",
+	Announcement:   "📢 %s",
+
+	WerewolvesDiscussing:  "人狼 (%s) が相談中...",
+	WerewolfRound:         "[%s] (人狼 第 %d 巡): %s",
+	WerewolfProposal:      "[%s] (人狼 第 %d 巡) %s の襲撃を提案: %s",
+	WerewolvesAgreed:      "✅ 人狼の意見が一致しました！",
+	WerewolvesNoAgreement: "⚠️ 人狼の意見がまとまりませんでした",
+	WerewolvesNoTarget:    "⚠️ 人狼から有効な襲撃先の提案がないため、今夜は誰も襲撃されません",
+	WerewolvesDecided:     "➡️ 人狼の襲撃先: %s (%s)",
+	WerewolvesEmptyKill:   "➡️ 人狼は襲撃なしを選びました (%s)",
+
+	WitchDeciding: "魔女 (%s) が判断中...",
+	WitchSaved:    "➡️ 魔女が %s を救いました！",
+	WitchPoisoned: "➡️ 魔女が %s に毒を盛りました！",
+	WitchNoAction: "魔女は薬を使いませんでした",
+
+	SeerChecking: "占い師 (%s) が占い中...",
+	SeerResult:   "➡️ 占い師が %s を占った結果: %s",
+
+	NightSummary:  "夜の結果",
+	PeacefulNight: "✨ 平和な夜でした。死者はいません。",
+	NightDeaths:   "☠️ 夜の結果、死亡: %s",
+
+	AlivePlayers:    "📢 生存プレイヤー: %s",
+	DiscussionPhase: "💬 議論フェーズ:",
+	DiscussionRound: "💬 第 %d 巡の議論 (%s)",
+	PlayerSpeaks:    "[%s]: %s",
+	VotingPhase:     "🗳️ 投票フェーズ:",
+	PlayerVotes:     "[%s] 投票: %s",
+	PlayerAbstains:  "[%s] 棄権",
+	PlayerSpoiled:   "[%s] 無効票（%s）",
+	NoValidVotes:    "有効な投票がありません",
+	VoteNoQuorum:    "有効票 %d 票（生存 %d 人）で、追放に必要な %d 票に届かないため、今回は誰も追放されません (%s)",
+	VoteResult:      "➡️ 投票結果: %s が追放されました (%s)",
+
+	NoSheriff:        "⚠️ 生存している警長がいないため、時計回りで発言します",
+	SheriffDirection: "👮 警長 %s が%sでの発言を選びました",
+	Clockwise:        "時計回り",
+	CounterClockwise: "反時計回り",
+	RebuttalPhase:    "🗣️ 反論タイム:",
+	Rebuttal:         "[%s] (反論): %s",
+
+	LastWords: "[%s] (遺言): %s",
+
+	HunterShot: "🔫 狩人が %s を撃ちました！",
+
+	TargetRejected: "⚠️ %s が無効な対象（%s）を繰り返し選んだため、この行動は無効になりました",
+
+	WerewolvesWin: "🐺 人狼陣営の勝利！",
+	VillagersWin:  "👨‍🌾 村人陣営の勝利！",
+	Roles:         "役職",
+	FinalRoles:    "=== 最終役職公開 ===",
+	StatusAlive:   "生存",
+	StatusDead:    "死亡",
+
+	PlayerReflections: "=== 🎭 プレイヤーの振り返り ===",
+	Reflection:        "[%s] 振り返り: %s",
+
+	ModDeaths:       "昨夜、%s が犠牲になりました。",
+	ModPeace:        "昨夜は平和な夜でした。犠牲者はいません。",
+	ModDiscussStart: "議論フェーズに入ります。順番に発言してください。",
+	ModVoteStart:    "議論終了です。人狼だと思うプレイヤーに投票してください。",
+	ModNightFall:    "夜になりました。全員目を閉じてください。",
+	ModWolvesWake:   "人狼は目を開けて、今夜襲撃するプレイヤーを選んでください。",
+	ModWitchWake:    "魔女は目を開けてください。",
+	ModSeerWake:     "占い師は目を開けて、占うプレイヤーを選んでください。",
+	ModDawn:         "朝になりました。全員目を開けてください。",
+	ModSheriff:      "警長 %s が%sでの発言を選びました。",
+
+	PhaseNight:      "🌙 夜フェーズ",
+	PhaseDay:        "☀️ 昼フェーズ",
+	PhaseDiscussion: "💬 議論フェーズ",
+	PhaseRebuttal:   "🗣️ 反論タイム",
+	PhaseVote:       "🗳️ 投票フェーズ",
+
+	Error: "⚠️ [%s] 呼び出しエラー: %v",
+
+	ClaimPhrases: []string{"私は%s", "私が%s", "僕が%s", "本物の%s"},
+
+	LessonPrefixes: []string{"教訓：", "教訓:"},
+
+	ClaimRole:     "%sを名乗る",
+	ClaimCheck:    "%s を%sと判定",
+	ClaimGood:     "白",
+	ClaimSuspects: "%s を疑う",
+	ClaimTrusted:  "%s を信頼",
+	CounterClaim:  "⚠️ %s が%sで対抗",
+	ClaimsSummary: "📋 公開された主張:\n%s",
+
+	Commentary: "🎙️ [実況] %s",
+
+	GameSummary:        "\nゲーム %s 終了：勝者 %s、%d ラウンド",
+	SimulationProgress: "シミュレーション進捗: %d/%d 局完了",
+	ExperimentProgress: "実験進捗: %d/%d 局完了",
+
+	Tools: ToolText{
+		Discuss: ToolSpec{
+			Desc: "人狼専用の相談ツール：他の人狼と話し合い、今夜の襲撃対象を提案する",
+			Params: map[string]string{
+				"target":  "今夜襲撃を提案するプレイヤー名。このボードで襲撃なしが認められている場合は none、発言だけで提案しない場合は空欄",
+				"message": "他の人狼に伝えたいこと（提案の理由を含む）",
+			},
+		},
+		Kill: ToolSpec{
+			Desc:   "人狼の襲撃ツール：今夜襲撃するプレイヤーを選ぶ",
+			Params: map[string]string{"target": "襲撃するプレイヤー名"},
+		},
+		Check: ToolSpec{
+			Desc:   "占い師の占いツール：一人のプレイヤーの正体を占う",
+			Params: map[string]string{"target": "占うプレイヤー名"},
+		},
+		Save: ToolSpec{
+			Desc:   "魔女の救命ツール：人狼に襲撃されたプレイヤーに解毒薬を使う",
+			Params: map[string]string{"save": "解毒薬を使うかどうか"},
+		},
+		Poison: ToolSpec{
+			Desc: "魔女の毒ツール：一人のプレイヤーに毒薬を使う",
+			Params: map[string]string{
+				"poison": "毒薬を使うかどうか",
+				"target": "毒を盛るプレイヤー名（毒薬を使う場合）",
+			},
+		},
+		Shoot: ToolSpec{
+			Desc: "ハンターの銃撃ツール：脱落したとき、一人のプレイヤーを撃って道連れにできる",
+			Params: map[string]string{
+				"shoot":  "撃つかどうか",
+				"target": "撃つプレイヤー名（撃つ場合）",
+			},
+		},
+		Vote: ToolSpec{
+			Desc: "投票ツール：昼にプレイヤーを追放する票を投じる。棄権もできる",
+			Params: map[string]string{
+				"target":  "追放に投票するプレイヤー名。棄権する場合は空欄",
+				"abstain": "棄権するかどうか（誰にも投票しない）",
+			},
+		},
+		Speech: ToolSpec{
+			Desc: "構造化発言ツール（任意）：昼の発言で、名乗る役職、占い結果、疑っているプレイヤーと信頼しているプレイヤーを公表する",
+			Params: map[string]string{
+				"claim_role": "公に名乗る役職。werewolf、villager、seer、witch、hunter のいずれか。名乗らない場合は空欄",
+				"checks":     "公表する占い結果",
+				"target":     "占ったと主張するプレイヤー名",
+				"is_wolf":    "主張する占い結果が人狼かどうか",
+				"suspects":   "人狼だと疑っているプレイヤー名",
+				"trusted":    "信頼しているプレイヤー名",
+			},
+		},
+		Belief: ToolSpec{
+			Desc:   "確率判断ツール（任意）：他の各プレイヤーが人狼である確率を提出する",
+			Params: map[string]string{"probs": "他の生存プレイヤーそれぞれが人狼である確率。キーはプレイヤー名、値は 0 から 1 の小数"},
+		},
+		Direction: ToolSpec{
+			Desc:   "発言方向ツール：警長が今日の発言方向を決める",
+			Params: map[string]string{"direction": "発言方向：clockwise（時計回り）または counterclockwise（反時計回り）"},
+		},
+
+		Killed:          "%s の襲撃を決定しました",
+		NoNightKill:     "今夜は人狼に襲撃された人はいません",
+		Saved:           "解毒薬で %s を救いました",
+		NoSave:          "解毒薬を使わないことにしました",
+		NoPoisonLeft:    "毒薬はすでに使用済みです",
+		NoPoison:        "毒薬を使わないことにしました",
+		NoPoisonTarget:  "毒を盛るプレイヤーを指定してください",
+		Poisoned:        "%s に毒薬を使いました",
+		NoShot:          "撃たないことにしました",
+		NoShootTarget:   "撃つプレイヤーを指定してください",
+		Shot:            "ハンターが %s を撃ちました",
+		Abstained:       "棄権しました",
+		Voted:           "%s の追放に投票しました",
+		SpeechRecorded:  "構造化発言を記録しました。続けて発言全文を述べてください",
+		NoBeliefs:       "有効な確率がありません。プレイヤー名をキー、0 から 1 の小数を値として再提出してください",
+		BeliefsRecorded: "%d 人のプレイヤーについての判断を記録しました",
+		BadDirection:    "発言方向は clockwise か counterclockwise のどちらかです",
+		DirectionChosen: "今日の発言方向：%s",
+	},
+
+	Log: game.LogText{
+		RoleNames: map[game.Role]string{
+			game.RoleWerewolf:   "人狼",
+			game.RoleHiddenWolf: "隠れ狼",
+			game.RoleVillager:   "村人",
+			game.RoleSeer:       "占い師",
+			game.RoleWitch:      "魔女",
+			game.RoleHunter:     "狩人",
+		},
+		FactionNames: map[game.Faction]string{
+			game.FactionWerewolf: "人狼陣営",
+			game.FactionVillager: "村人陣営",
+		},
+
+		FullTitle:            "# 🐺 人狼ゲーム完全ログ",
+		ReplayTitle:          "# 🎮 人狼ゲームリプレイ",
+		GameID:               "**ゲームID**: %s",
+		StartTime:            "**開始時刻**: %s",
+		RoleAssignment:       "## 📋 役職配布",
+		RoleTableHeader:      "| プレイヤー | 役職 |",
+		ReplayRoleAssignment: "## 役職配布",
+
+		Round:       "## 🔄 第 %d ラウンド",
+		ReplayRound: "## 第 %d ラウンド",
+		Moderator:   "🎭 **司会**: %s",
+
+		WolfConspiracy:       "### 🤝 人狼の密談",
+		ReplayWolfConspiracy: "🤝 人狼の密談 (%s)",
+		WolfIndividualVote:   "- **%s** 最終提案: %s",
+		WolfKill:             "**人狼の襲撃先**: %s (%s)",
+		ReplayWolfKill:       "🐺 人狼の襲撃: %s",
+		WolfNoKill:           "**人狼は襲撃なし**: (%s)",
+		ReplayWolfNoKill:     "🐺 人狼の襲撃なし",
+
+		SeerCheck:         "**占い結果**: %s → %s",
+		ReplaySeerCheck:   "🔮 占い師が %s を占った: %s",
+		WitchSave:         "**魔女が解毒薬を使用**: %s を救出",
+		ReplayWitchSave:   "💊 魔女が救出: %s",
+		WitchPoison:       "**魔女が毒薬を使用**: %s を毒殺",
+		ReplayWitchPoison: "☠️ 魔女が毒殺: %s",
+
+		NightSummary:     "**夜の結果**:",
+		NightKilledSaved: "- 人狼が %s を襲撃、魔女が救出",
+		NightKilled:      "- 人狼が %s を襲撃",
+		NightPoisoned:    "- 魔女が %s を毒殺",
+		NightShot:        "- 狩人が %s を射殺",
+
+		VoteResult:      "**投票結果**: %s が追放 (%s)",
+		VoteResultNone:  "**投票結果**: %s",
+		VoteAbstain:     "- %s 棄権",
+		VoteSpoiled:     "- %s 無効票（%s）",
+		ReplayVoteOut:   "🗳️ 投票で追放: %s",
+		LastWords:       "**[%s の遺言]**: %s",
+		ReplayLastWords: "💀 %s の遺言: %s",
+
+		HunterShoot:       "**狩人の発砲**: %s を射殺",
+		ReplayHunterShoot: "🔫 狩人が射殺: %s",
+
+		GameOver:        "## 🏆 ゲーム終了",
+		Winner:          "**勝者**: %s",
+		Survivors:       "**生存プレイヤー**: %s",
+		Duration:        "**プレイ時間**: %s",
+		ReplayWinner:    "## 🏆 %s の勝利！",
+		ReplaySurvivors: "生存: %s",
+
+		ReflectionPrefixes: []string{"振り返り:", "振り返り："},
+
+		CommentaryTitle: "# 🎙️ 実況記録",
+		CommentaryPhase: "## 第 %d ラウンド %s",
+
+		ReplaySetup:        "━━ 開始 ━━",
+		ReplayStep:         "━━ 第 %d ラウンド · %s ━━",
+		ReplayGodView:      "👁️ 神視点",
+		ReplaySeatView:     "👁️ %s の視点（%s）",
+		ReplayModerator:    "🎭 %s",
+		ReplaySpeech:       "🗣️ %s: %s",
+		ReplayWolfSpeech:   "🐺 %s: %s",
+		ReplayWolfVote:     "🐺 %s の最終提案は %s の襲撃",
+		ReplayWolfProposal: "🐺 %s（%s の襲撃を提案）: %s",
+		ReplayVote:         "🗳️ %s が %s に投票",
+		ReplayAbstain:      "🗳️ %s は棄権",
+		ReplaySpoiled:      "🗳️ %s の票は無効（%s）",
+		ReplayVoteResult:   "🗳️ 投票結果: %s",
+		ReplayDeath:        "💀 %s が脱落（%s）",
+		ReplayDeathUnknown: "💀 %s が脱落",
+		ReplayBelief:       "🎯 %s の確率判断: %s",
+		ReplayReflection:   "💭 %s: %s",
+		ReplayCommentary:   "🎙️ %s",
+		ReplayHelp:         "[Enter] 次のフェーズ  [b] 前へ  [r N] 第 N ラウンドへ  [q] 終了",
+		DeathCauses: map[string]string{
+			game.DeathKilled:   "人狼に襲撃された",
+			game.DeathPoisoned: "魔女に毒殺された",
+			game.DeathShot:     "ハンターに撃たれた",
+			game.DeathVoted:    "投票で追放された",
+		},
+		SpoiledReasons: map[string]string{
+			string(game.ViolationMissing): "対象が指定されていない",
+			string(game.ViolationUnknown): "対象がこのゲームに存在しない",
+			string(game.ViolationDead):    "対象は既に脱落している",
+			string(game.ViolationSelf):    "自分に投票した",
+			game.SpoiledNoResponse:        "応答なし",
+		},
+
+		TranscriptTitle:      "# 👁️ %s の視点（%s）",
+		TranscriptSystem:     "### ⚙️ システムプロンプト",
+		TranscriptUser:       "### 🎭 司会者",
+		TranscriptAssistant:  "### 💬 %s",
+		TranscriptToolCall:   "🔧 呼び出し `%s`: `%s`",
+		TranscriptToolResult: "### 🔧 ツール結果（%s）",
+
+		Saved: "ログを保存しました: %s",
+	},
+
+	Report: ReportText{
+		None: "なし",
+
+		AnalysisTitle:      "# 📊 試合後分析",
+		AnalysisSummary:    "**勝利陣営**: %s、**ラウンド数**: %d、**村人陣営の投票的中率**: %.1f%%",
+		PlayerMetrics:      "## 👥 プレイヤー指標",
+		PlayerTableHeader:  "| プレイヤー | 役職 | 脱落 | 発言 | 投票 | 得票 | 人狼への投票 | 的中率 | 疑い的中率 | 偽装スコア | 役職宣言 |",
+		SeerChecks:         "## 🔮 占い結果",
+		NoChecks:           "占いの記録はありません",
+		ChecksTableHeader:  "| ラウンド | 対象 | 結果 | その後の村人の投票 | 採用されたか |",
+		ClaimedChecks:      "## 📋 公表された占い結果",
+		ClaimedTableHeader: "| ラウンド | 公表者 | 対象 | 公表した結果 | 偽り |",
+		FakeCheck:          "❌ 偽り",
+		CounterClaims:      "## ⚔️ 対抗",
+		FalseClaims:        "## 🎭 偽りの役職宣言",
+		FalseClaim:         "- %d 日目 %s（%s）が %s だと宣言",
+		Beliefs:            "## 🎯 確率判断",
+		BeliefSummary:      "村人陣営全体: %d 件の判断、Brier %.3f、対数損失 %.3f（常に 0.5 と答えた場合は 0.250 / 0.693）",
+		BeliefTableHeader:  "| プレイヤー | 役職 | サンプル数 | Brier | 対数損失 |",
+		VoteFlow:           "## 🗳️ 投票の流れ",
+		VoteFlowDay:        "### %d 日目",
+
+		SimulationTitle:    "# 📊 人狼一括シミュレーションレポート",
+		SimulationGames:    "**有効な対局**: %d (失敗 %d)",
+		WinRates:           "## 🏆 勝率",
+		WinRateTableHeader: "| 陣営 | 対局数 | 勝率 | 95% CI |",
+		GameLength:         "## ⏱️ ゲームの長さ",
+		MeanRounds:         "平均ラウンド数: %s",
+		FirstNightKills:    "## 🐺 初夜の襲撃先",
+		FirstKillHeader:    "| 役職 | 対局数 | 割合 | 95% CI |",
+		SeerSurvival:       "## 🔮 占い師の生存",
+		SurvivalRate:       "生存率: %s",
+		Potions:            "## 🧙‍♀️ 薬の使用",
+		PotionTableHeader:  "| 薬 | 使用率 | 平均使用ラウンド |",
+		HealingPotion:      "解毒薬",
+		PoisonPotion:       "毒薬",
+		Fallbacks:          "## ⚠️ フォールバック",
+		FallbackSummary:    "フォールバック合計: %d、1 局あたり: %s",
+		Calibration:        "## 🎯 確率判断の較正（村人陣営）",
+		CalibrationNote:    "Brier スコアと対数損失は低いほど良く、常に 0.5 と答えた場合はそれぞれ 0.250 と 0.693 です。",
+		CalibrationHeader:  "| モデル | サンプル数 | Brier | 対数損失 |",
+		CalibrationCurve:   "**%s の較正曲線**",
+		CalibrationBins:    "| 予測区間 | サンプル数 | 平均予測 | 実際に人狼だった割合 |",
+
+		ExperimentTitle:     "# 🧪 プロンプト実験レポート: %s",
+		ExperimentPairs:     "**有効なペア**: %d (破棄 %d)、対照群: `%s`、実験群: `%s`",
+		ExperimentMetrics:   "## 📈 指標の比較",
+		ExperimentHeader:    "| 指標 | ペア数 | 対照群 | 実験群 | 差 | 95% CI | p 値 |",
+		ExperimentWinRate:   "%sの勝率",
+		ExperimentTargetWin: "変種プレイヤーの陣営の勝率",
+		ExperimentSeer:      "占い師の生存率",
+		ExperimentLength:    "平均ラウンド数",
+		ExperimentFallbacks: "1 局あたりのフォールバック",
+		ExperimentNote:      "割合の指標は McNemar の正確検定、数値の指標はペア差の正規近似で検定しています。同じペアの 2 局は同じシードを使うため役職配分は同じですが、その後の展開は異なる場合があります。",
+	},
 }
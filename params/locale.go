@@ -0,0 +1,172 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package params
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+// Locale 单局游戏使用的语言包（提示词 + 界面文案）
+// 每局游戏持有自己的 Locale，多局并行时互不影响
+type Locale struct {
+	Lang         string
+	Prompts      PromptsTemplate
+	I18n         I18nStrings
+	RoleGuidance map[game.Role]string
+	Variants     []PromptVariant        // 按角色或座位注入的提示词变体（A/B 实验）
+	Lessons      map[game.Role][]string // 从经验库注入的往届经验
+	Roles        []game.Role            // 本局发出的全部角色，为空时使用默认板子的角色
+}
+
+// NewLocale 根据语言代码创建语言包：en 为英文，ja 为日文，其余为中文
+func NewLocale(lang string) *Locale {
+	switch strings.ToLower(lang) {
+	case "ja":
+		return &Locale{
+			Lang:         "ja",
+			Prompts:      JapanesePrompts,
+			I18n:         JapaneseI18n,
+			RoleGuidance: maps.Clone(RoleGuidance),
+		}
+	case "en":
+		return &Locale{
+			Lang:         "en",
+			Prompts:      EnglishPrompts,
+			I18n:         EnglishI18n,
+			RoleGuidance: maps.Clone(RoleGuidance),
+		}
+	default:
+		return &Locale{
+			Lang:         "zh",
+			Prompts:      ChinesePrompts,
+			I18n:         ChineseI18n,
+			RoleGuidance: maps.Clone(RoleGuidance),
+		}
+	}
+}
+
+// WithVariants 返回应用了提示词变体的语言包副本，原语言包不受影响
+func (l *Locale) WithVariants(variants []PromptVariant) *Locale {
+	clone := *l
+	clone.RoleGuidance = maps.Clone(l.RoleGuidance)
+	clone.Variants = append(slices.Clone(l.Variants), variants...)
+	return &clone
+}
+
+// WithLessons 返回注入了往届经验的语言包副本，原语言包不受影响
+func (l *Locale) WithLessons(lessons map[game.Role][]string) *Locale {
+	clone := *l
+	clone.RoleGuidance = maps.Clone(l.RoleGuidance)
+	clone.Lessons = lessons
+	return &clone
+}
+
+// WithRoles 返回记录了本局角色的语言包副本，原语言包不受影响
+func (l *Locale) WithRoles(roles []game.Role) *Locale {
+	clone := *l
+	clone.Roles = slices.Clone(roles)
+	return &clone
+}
+
+// compositionOrder 角色构成中各角色的列出顺序
+var compositionOrder = []game.Role{
+	game.RoleWerewolf, game.RoleHiddenWolf, game.RoleVillager,
+	game.RoleSeer, game.RoleWitch, game.RoleHunter,
+}
+
+// Composition 返回本局角色构成的本地化说明，如“2 个狼人、1 个隐狼、3 个村民……”
+func (l *Locale) Composition() string {
+	roles := l.Roles
+	if len(roles) == 0 {
+		roles = DefaultBoard.Roles()
+	}
+	counts := make(map[game.Role]int)
+	for _, r := range roles {
+		counts[r]++
+	}
+	var parts []string
+	for _, r := range compositionOrder {
+		if counts[r] > 0 {
+			parts = append(parts, fmt.Sprintf(l.I18n.RoleCount, counts[r], l.I18n.RoleNames[r]))
+		}
+	}
+	text := strings.Join(parts, l.I18n.RoleSeparator)
+	if counts[game.RoleHiddenWolf] > 0 {
+		text += l.I18n.HiddenWolfNote
+	}
+	return text
+}
+
+// BuildPlayerInstruction 构建玩家系统提示
+// 该角色有往届经验时附在角色指导之后；匹配该玩家的变体会替换角色指导或在末尾追加内容，后面的变体优先
+func (l *Locale) BuildPlayerInstruction(name string, role game.Role) string {
+	guidance := l.RoleGuidance[role]
+	var extra []string
+	if lessons := l.Lessons[role]; len(lessons) > 0 {
+		extra = append(extra, fmt.Sprintf(l.Prompts.ToLessons, "- "+strings.Join(lessons, "\n- ")))
+	}
+	for _, v := range l.Variants {
+		if !v.Matches(name, role) {
+			continue
+		}
+		if v.Guidance != "" {
+			guidance = v.Guidance
+		}
+		if v.Append != "" {
+			extra = append(extra, v.Append)
+		}
+	}
+
+	instruction := fmt.Sprintf(l.Prompts.BaseSystem, name, l.Composition(), role, guidance)
+	if len(extra) > 0 {
+		instruction += "\n\n" + strings.Join(extra, "\n\n")
+	}
+	return instruction
+}
+
+// IllegalTarget 返回目标不合法时告诉玩家的提示：不合法的原因和当前可以选择的目标
+func (l *Locale) IllegalTarget(state *game.GameState, err *game.TargetError) string {
+	return fmt.Sprintf(l.Prompts.ToIllegalTarget, strconv.Quote(err.Target), l.ViolationText(err.Reason),
+		strings.Join(state.LegalTargets(err.Action, err.Actor), ", "))
+}
+
+// ViolationText 返回目标不合法原因的本地化说明
+func (l *Locale) ViolationText(reason game.Violation) string {
+	p := l.Prompts
+	switch reason {
+	case game.ViolationMissing:
+		return p.ToIllegalMissing
+	case game.ViolationDead:
+		return p.ToIllegalDead
+	case game.ViolationSelf:
+		return p.ToIllegalSelf
+	case game.ViolationTeammate:
+		return p.ToIllegalTeammate
+	case game.ViolationNoPotion:
+		return p.ToIllegalNoPotion
+	case game.ViolationNoKill:
+		return p.ToIllegalNoKill
+	default:
+		return p.ToIllegalUnknown
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package params
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+func TestPlayerInstructionComposition(t *testing.T) {
+	hidden := DefaultBoard
+	hidden.HiddenWolves = 1
+
+	tests := []struct {
+		lang    string
+		board   BoardConfig
+		want    string
+		hasNote bool
+	}{
+		{"zh", DefaultBoard, "3 个狼人、3 个村民、1 个预言家、1 个女巫、1 个猎人", false},
+		{"zh", hidden, "2 个狼人、1 个隐狼、3 个村民、1 个预言家、1 个女巫、1 个猎人", true},
+		{"en", hidden, "2 × Werewolf, 1 × Hidden Wolf, 3 × Villager, 1 × Seer, 1 × Witch, 1 × Hunter", true},
+		{"ja", hidden, "人狼2人、隠れ狼1人、村人3人、占い師1人、魔女1人、狩人1人", true},
+	}
+	for _, tt := range tests {
+		locale := NewLocale(tt.lang).WithRoles(tt.board.Roles())
+		instruction := locale.BuildPlayerInstruction("Player1", game.RoleVillager)
+		if !strings.Contains(instruction, tt.want) {
+			t.Errorf("%s 隐狼 %d：系统提示中没有角色构成 %q\n%s", tt.lang, tt.board.HiddenWolves, tt.want, instruction)
+		}
+		if got := strings.Contains(instruction, locale.I18n.HiddenWolfNote); got != tt.hasNote {
+			t.Errorf("%s 隐狼 %d：包含隐狼说明 = %v，期望 %v", tt.lang, tt.board.HiddenWolves, got, tt.hasNote)
+		}
+	}
+
+	// 没有记录本局角色时按默认板子生成
+	if got, want := NewLocale("zh").Composition(), NewLocale("zh").WithRoles(DefaultBoard.Roles()).Composition(); got != want {
+		t.Errorf("默认角色构成 = %q，期望 %q", got, want)
+	}
+}
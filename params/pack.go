@@ -0,0 +1,343 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package params
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+// promptVar 提示词模板变量，Verb 为渲染时使用的格式化动词
+type promptVar struct {
+	Name string
+	Verb string
+}
+
+func strVar(name string) promptVar { return promptVar{Name: name, Verb: "s"} }
+func intVar(name string) promptVar { return promptVar{Name: name, Verb: "d"} }
+
+// promptSpecs 每个提示词模板允许（且必须）使用的命名变量，顺序与调用方传参顺序一致
+var promptSpecs = map[string][]promptVar{
+	"BaseSystem": {strVar("Name"), strVar("Composition"), strVar("Role"), strVar("Guidance")},
+
+	"ToDeadPlayer": {strVar("Player")},
+	"ToAllNewGame": {strVar("Players")},
+	"ToAllNight":   {},
+
+	"ToWolvesDiscussion": {strVar("Wolves"), strVar("AlivePlayers")},
+	"ToWolvesProposals":  {strVar("Proposals")},
+	"ToWolvesHistory":    {strVar("History")},
+	"ToHistoryModerator": {strVar("Message")},
+	"ToHistorySelf":      {strVar("Message")},
+	"ToWolvesRes":        {strVar("Details"), strVar("Target")},
+	"ToWolvesAgreed":     {},
+	"ToWolvesLeader":     {strVar("Leader")},
+	"ToWolvesMajority":   {},
+	"ToWolvesRandom":     {},
+	"ToWolvesNoKill":     {strVar("Details")},
+	"ToWolvesEmptyKill":  {},
+	"ToWolvesSelfKnife":  {},
+
+	"ToAllWitchTurn":      {},
+	"ToWitchResurrect":    {strVar("Witch"), strVar("Killed")},
+	"ToWitchResurrectNo":  {},
+	"ToWitchResurrectYes": {},
+	"ToWitchPoison":       {strVar("Witch")},
+
+	"ToAllSeerTurn": {},
+	"ToSeer":        {strVar("Seer")},
+	"ToSeerResult":  {strVar("Target"), strVar("Result")},
+
+	"ToHunter":         {strVar("Hunter")},
+	"ToAllHunterShoot": {strVar("Target")},
+
+	"ToAllDay":      {strVar("Dead")},
+	"ToAllPeace":    {},
+	"ToAllDiscuss":  {strVar("AlivePlayers"), strVar("Order")},
+	"ToAllVote":     {strVar("AlivePlayers")},
+	"ToAllRes":      {strVar("Details"), strVar("Target")},
+	"ToAllResNone":  {strVar("Details"), intVar("Valid"), intVar("Required")},
+	"ToVoteAbstain": {strVar("Players")},
+	"ToVoteSpoiled": {strVar("Players")},
+	"ToVoteTie":     {strVar("Players"), strVar("Winner")},
+
+	"ToAllDiscussRound": {intVar("Round"), strVar("Order")},
+	"ToPlayerSpeak":     {},
+	"ToSpeechTool":      {},
+	"ToSheriffOrder":    {strVar("Sheriff")},
+	"ToSheriffElection": {strVar("AlivePlayers")},
+	"ToAllSheriff":      {strVar("Details"), strVar("Sheriff")},
+	"ToAllNoSheriff":    {strVar("Details")},
+	"ToSheriffPass":     {strVar("Sheriff"), strVar("AlivePlayers")},
+	"ToAllBadgePassed":  {strVar("Sheriff"), strVar("Successor")},
+	"ToAllBadgeTorn":    {strVar("Sheriff")},
+	"ToRebuttal":        {strVar("Accusations")},
+	"ToAllRebuttal":     {strVar("Player"), strVar("Message")},
+	"ToAllLastWords":    {strVar("Player"), strVar("Message")},
+	"ToAllClaims":       {strVar("Summary")},
+	"ToBelief":          {strVar("Players")},
+
+	"ToIllegalTarget":   {strVar("Target"), strVar("Reason"), strVar("Targets")},
+	"ToIllegalMissing":  {},
+	"ToIllegalUnknown":  {},
+	"ToIllegalDead":     {},
+	"ToIllegalSelf":     {},
+	"ToIllegalTeammate": {},
+	"ToIllegalNoPotion": {},
+	"ToIllegalNoKill":   {},
+
+	"CommentatorSystem": {},
+	"ToCommentator":     {strVar("Phase"), strVar("State"), strVar("Events")},
+
+	"ToLessons":          {strVar("Lessons")},
+	"ToSummarizeLessons": {strVar("Role"), strVar("Lessons"), intVar("Count")},
+
+	"ToAllWolfWin":    {intVar("AliveCount"), intVar("WolfCount"), strVar("Roles")},
+	"ToAllVillageWin": {strVar("Roles")},
+	"ToAllContinue":   {},
+	"ToAllReflect":    {},
+	"ToDeadCatchUp":   {intVar("Count"), strVar("Messages")},
+}
+
+// 调用方按位置传参的模板：ToWitchResurrect 传入 (witch, killed, killed)
+var promptArgAliases = map[string][]string{
+	"ToWitchResurrect": {"Witch", "Killed", "Killed"},
+}
+
+// promptPackFile 提示词包文件格式（YAML）
+//
+//	lang: zh            # 界面语言，同时是未指定 base 时的默认语言
+//	base: zh            # 可选，未在 prompts 中给出的模板从该语言继承
+//	prompts:
+//	  ToSeer: "[仅预言家可见] {{.Seer}}，今晚你要查验谁？"
+//	role_guidance:
+//	  seer: "..."
+type promptPackFile struct {
+	Lang         string            `yaml:"lang"`
+	Base         string            `yaml:"base"`
+	Prompts      map[string]string `yaml:"prompts"`
+	RoleGuidance map[string]string `yaml:"role_guidance"`
+}
+
+// LoadLocale 从 YAML 提示词包加载语言包
+// 模板使用 text/template 的命名变量（如 {{.Seer}}），加载时校验模板齐全、变量合法
+func LoadLocale(path string) (*Locale, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取提示词包失败: %w", err)
+	}
+
+	var pack promptPackFile
+	if err := yaml.Unmarshal(data, &pack); err != nil {
+		return nil, fmt.Errorf("解析提示词包 %s 失败: %w", path, err)
+	}
+
+	lang := pack.Lang
+	if lang == "" {
+		lang = pack.Base
+	}
+	locale := NewLocale(lang)
+
+	var errs []string
+	prompts := reflect.ValueOf(&locale.Prompts).Elem()
+	promptsType := prompts.Type()
+
+	// 未知模板名
+	for name := range pack.Prompts {
+		if _, ok := promptSpecs[name]; !ok {
+			errs = append(errs, fmt.Sprintf("未知模板 %s", name))
+		}
+	}
+
+	for i := 0; i < promptsType.NumField(); i++ {
+		name := promptsType.Field(i).Name
+		spec, ok := promptSpecs[name]
+		if !ok {
+			return nil, fmt.Errorf("模板 %s 缺少变量定义", name)
+		}
+
+		text, ok := pack.Prompts[name]
+		if !ok {
+			// 未指定 base 时要求所有模板都存在
+			if pack.Base == "" {
+				errs = append(errs, fmt.Sprintf("缺少模板 %s", name))
+			}
+			continue
+		}
+
+		format, err := compilePrompt(name, text, spec)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		prompts.Field(i).SetString(format)
+	}
+
+	for role, guidance := range pack.RoleGuidance {
+		r := game.Role(role)
+		if _, ok := RoleGuidance[r]; !ok {
+			errs = append(errs, fmt.Sprintf("未知角色 %s", role))
+			continue
+		}
+		locale.RoleGuidance[r] = guidance
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("提示词包 %s 校验失败:\n  %s", path, strings.Join(errs, "\n  "))
+	}
+	return locale, nil
+}
+
+// compilePrompt 将命名变量模板编译为带显式参数索引的格式化字符串
+// 例如 "{{.Seer}}，你要查谁？" → "%[1]s，你要查谁？"，调用方仍按位置传参
+func compilePrompt(name, text string, spec []promptVar) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("模板 %s 语法错误: %v", name, err)
+	}
+
+	// 校验变量：不允许未定义的变量，也不允许遗漏必需的变量
+	allowed := make(map[string]promptVar)
+	for _, v := range spec {
+		allowed[v.Name] = v
+	}
+	used := make(map[string]bool)
+	var unknown []string
+	walkFields(tmpl.Tree.Root, func(field string) {
+		if _, ok := allowed[field]; !ok {
+			unknown = append(unknown, field)
+			return
+		}
+		used[field] = true
+	})
+	if len(unknown) > 0 {
+		return "", fmt.Errorf("模板 %s 使用了未定义的变量 %s（可用变量: %s）", name, strings.Join(unknown, ", "), varNames(spec))
+	}
+	for _, v := range spec {
+		if !used[v.Name] {
+			return "", fmt.Errorf("模板 %s 缺少变量 {{.%s}}", name, v.Name)
+		}
+	}
+
+	// 无变量的模板调用方直接使用原文；有变量时文本中的 % 需要转义，避免被当作格式化动词
+	if len(spec) > 0 {
+		escapePercent(tmpl.Tree.Root)
+	}
+
+	// 每个变量渲染为指向其参数位置的格式化动词
+	args := promptArgAliases[name]
+	if args == nil {
+		for _, v := range spec {
+			args = append(args, v.Name)
+		}
+	}
+	placeholders := make(map[string]string)
+	for i, arg := range args {
+		if _, ok := placeholders[arg]; !ok {
+			placeholders[arg] = fmt.Sprintf("%%[%d]%s", i+1, allowed[arg].Verb)
+		}
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, placeholders); err != nil {
+		return "", fmt.Errorf("模板 %s 渲染失败: %v", name, err)
+	}
+	return sb.String(), nil
+}
+
+// walkFields 遍历模板语法树中引用的顶层变量
+func walkFields(node parse.Node, fn func(string)) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			walkFields(child, fn)
+		}
+	case *parse.ActionNode:
+		walkFields(n.Pipe, fn)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				walkFields(arg, fn)
+			}
+		}
+	case *parse.FieldNode:
+		fn(n.Ident[0])
+	case *parse.IfNode:
+		walkFields(n.Pipe, fn)
+		walkFields(n.List, fn)
+		walkFields(n.ElseList, fn)
+	case *parse.RangeNode:
+		walkFields(n.Pipe, fn)
+		walkFields(n.List, fn)
+		walkFields(n.ElseList, fn)
+	case *parse.WithNode:
+		walkFields(n.Pipe, fn)
+		walkFields(n.List, fn)
+		walkFields(n.ElseList, fn)
+	}
+}
+
+// escapePercent 将模板文本节点中的 % 转义为 %%
+func escapePercent(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			escapePercent(child)
+		}
+	case *parse.TextNode:
+		n.Text = []byte(strings.ReplaceAll(string(n.Text), "%", "%%"))
+	case *parse.IfNode:
+		escapePercent(n.List)
+		escapePercent(n.ElseList)
+	case *parse.RangeNode:
+		escapePercent(n.List)
+		escapePercent(n.ElseList)
+	case *parse.WithNode:
+		escapePercent(n.List)
+		escapePercent(n.ElseList)
+	}
+}
+
+func varNames(spec []promptVar) string {
+	if len(spec) == 0 {
+		return "无"
+	}
+	names := make([]string, 0, len(spec))
+	for _, v := range spec {
+		names = append(names, "{{."+v.Name+"}}")
+	}
+	return strings.Join(names, ", ")
+}
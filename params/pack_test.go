@@ -0,0 +1,200 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package params
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompilePrompt(t *testing.T) {
+	tests := []struct {
+		name   string
+		prompt string
+		text   string
+		want   string
+	}{
+		{"单个变量", "ToSeer", "{{.Seer}}，你要查谁？", "%[1]s，你要查谁？"},
+		{"按定义顺序编号", "ToWolvesRes", "目标 {{.Target}}，票型 {{.Details}}", "目标 %[2]s，票型 %[1]s"},
+		{"整数变量", "ToAllResNone", "{{.Details}}（{{.Valid}}/{{.Required}}）", "%[1]s（%[2]d/%[3]d）"},
+		{"同一变量多次使用", "ToSeer", "{{.Seer}}，{{.Seer}}", "%[1]s，%[1]s"},
+		{"按位置传参的别名", "ToWitchResurrect", "{{.Killed}} 被杀了，{{.Witch}} 要救吗？", "%[2]s 被杀了，%[1]s 要救吗？"},
+		{"有变量时转义百分号", "ToSeerResult", "{{.Target}} 有 100% 是 {{.Result}}", "%[1]s 有 100%% 是 %[2]s"},
+		{"无变量时保留百分号", "ToAllNight", "天黑了，100% 安静", "天黑了，100% 安静"},
+		{"条件块中的变量", "ToSeer", "{{if .Seer}}{{.Seer}} 请睁眼{{end}}", "%[1]s 请睁眼"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := compilePrompt(tt.prompt, tt.text, promptSpecs[tt.prompt])
+			if err != nil {
+				t.Fatalf("compilePrompt() 错误: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("compilePrompt() = %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompilePromptRendersWithPositionalArgs(t *testing.T) {
+	format, err := compilePrompt("ToWitchResurrect", "{{.Witch}}，{{.Killed}} 被杀了，要救 {{.Killed}} 吗？", promptSpecs["ToWitchResurrect"])
+	if err != nil {
+		t.Fatalf("compilePrompt() 错误: %v", err)
+	}
+	// 调用方与内置模板一样传入 (witch, killed, killed)
+	got := fmt.Sprintf(format, "Player4", "Player7", "Player7")
+	if want := "Player4，Player7 被杀了，要救 Player7 吗？"; got != want {
+		t.Errorf("渲染结果 %q，期望 %q", got, want)
+	}
+}
+
+func TestCompilePromptErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		prompt  string
+		text    string
+		wantErr string
+	}{
+		{"未定义的变量", "ToSeer", "{{.Seer}} 查验 {{.Target}}", "未定义的变量 Target"},
+		{"缺少变量", "ToSeerResult", "你查验了 {{.Target}}", "缺少变量 {{.Result}}"},
+		{"无变量模板使用变量", "ToAllNight", "{{.Round}} 天黑了", "未定义的变量 Round"},
+		{"语法错误", "ToSeer", "{{.Seer}", "语法错误"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := compilePrompt(tt.prompt, tt.text, promptSpecs[tt.prompt])
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("compilePrompt() 错误 = %v，期望包含 %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPromptSpecsCoverTemplate(t *testing.T) {
+	fields := reflect.TypeOf(PromptsTemplate{})
+	for i := 0; i < fields.NumField(); i++ {
+		if _, ok := promptSpecs[fields.Field(i).Name]; !ok {
+			t.Errorf("模板 %s 缺少变量定义", fields.Field(i).Name)
+		}
+	}
+	if len(promptSpecs) != fields.NumField() {
+		t.Errorf("promptSpecs 有 %d 项，PromptsTemplate 有 %d 个字段", len(promptSpecs), fields.NumField())
+	}
+}
+
+// writePack 把提示词包写入临时文件并返回路径
+func writePack(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "pack.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("写入提示词包失败: %v", err)
+	}
+	return path
+}
+
+func TestLoadLocaleInheritsBase(t *testing.T) {
+	locale, err := LoadLocale(writePack(t, `
+lang: en
+base: en
+prompts:
+  ToSeer: "{{.Seer}}, pick someone to check."
+role_guidance:
+  seer: "Check the loudest player."
+`))
+	if err != nil {
+		t.Fatalf("LoadLocale() 错误: %v", err)
+	}
+	if locale.Lang != "en" || locale.Prompts.ToSeer != "%[1]s, pick someone to check." {
+		t.Errorf("ToSeer = %q，语言 %s", locale.Prompts.ToSeer, locale.Lang)
+	}
+	if locale.Prompts.ToSeerResult != EnglishPrompts.ToSeerResult {
+		t.Errorf("未覆盖的模板应继承英文内置模板，得到 %q", locale.Prompts.ToSeerResult)
+	}
+	if locale.RoleGuidance["seer"] != "Check the loudest player." {
+		t.Errorf("角色指导没有覆盖: %q", locale.RoleGuidance["seer"])
+	}
+	if RoleGuidance["seer"] == "Check the loudest player." {
+		t.Error("加载提示词包修改了内置角色指导")
+	}
+}
+
+func TestLoadLocaleErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		pack    string
+		wantErr string
+	}{
+		{"未知模板", "base: zh\nprompts:\n  ToNobody: \"hi\"\n", "未知模板 ToNobody"},
+		{"没有 base 时缺少模板", "lang: zh\nprompts:\n  ToAllNight: \"天黑了\"\n", "缺少模板 ToSeer"},
+		{"未知角色", "base: zh\nrole_guidance:\n  mayor: \"...\"\n", "未知角色 mayor"},
+		{"模板变量错误", "base: zh\nprompts:\n  ToSeer: \"{{.Name}}\"\n", "未定义的变量 Name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadLocale(writePack(t, tt.pack))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadLocale() 错误 = %v，期望包含 %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExamplePackLoads(t *testing.T) {
+	if _, err := LoadLocale(filepath.Join("..", "prompts", "example.yaml")); err != nil {
+		t.Fatalf("示例提示词包加载失败: %v", err)
+	}
+}
+
+func TestBuiltinPromptsFormatCleanly(t *testing.T) {
+	for _, lang := range []string{"zh", "en", "ja"} {
+		t.Run(lang, func(t *testing.T) {
+			prompts := reflect.ValueOf(NewLocale(lang).Prompts)
+			for i := 0; i < prompts.NumField(); i++ {
+				name := prompts.Type().Field(i).Name
+				text := prompts.Field(i).String()
+				if text == "" {
+					t.Errorf("%s 为空", name)
+					continue
+				}
+
+				// 按调用方的传参方式构造参数：有别名时按别名的个数，否则按变量定义
+				spec := promptSpecs[name]
+				var args []interface{}
+				if aliases, ok := promptArgAliases[name]; ok {
+					for range aliases {
+						args = append(args, "Player1")
+					}
+				} else {
+					for _, v := range spec {
+						if v.Verb == "d" {
+							args = append(args, 1)
+						} else {
+							args = append(args, "Player1")
+						}
+					}
+				}
+				if got := fmt.Sprintf(text, args...); strings.Contains(got, "%!") {
+					t.Errorf("%s 传入 %d 个参数后格式化出错: %q", name, len(args), got)
+				}
+			}
+		})
+	}
+}
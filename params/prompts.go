@@ -17,8 +17,6 @@
 package params
 
 import (
-	"fmt"
-
 	"github.com/ashwinyue/wolf-go-adk/game"
 )
 
@@ -37,8 +35,18 @@ type PromptsTemplate struct {
 
 	// 狼人相关
 	ToWolvesDiscussion string
-	ToWolvesVote       string
+	ToWolvesProposals  string
+	ToWolvesHistory    string
+	ToHistoryModerator string
+	ToHistorySelf      string
 	ToWolvesRes        string
+	ToWolvesAgreed     string
+	ToWolvesLeader     string
+	ToWolvesMajority   string
+	ToWolvesRandom     string
+	ToWolvesNoKill     string
+	ToWolvesEmptyKill  string
+	ToWolvesSelfKnife  string
 
 	// 女巫相关
 	ToAllWitchTurn      string
@@ -57,22 +65,59 @@ type PromptsTemplate struct {
 	ToAllHunterShoot string
 
 	// 白天阶段
-	ToAllDay     string
-	ToAllPeace   string
-	ToAllDiscuss string
-	ToAllVote    string
-	ToAllRes     string
+	ToAllDay      string
+	ToAllPeace    string
+	ToAllDiscuss  string
+	ToAllVote     string
+	ToAllRes      string
+	ToAllResNone  string
+	ToVoteAbstain string
+	ToVoteSpoiled string
+	ToVoteTie     string
+
+	// 讨论策略
+	ToAllDiscussRound string
+	ToPlayerSpeak     string
+	ToSpeechTool      string
+	ToSheriffOrder    string
+	ToSheriffElection string
+	ToAllSheriff      string
+	ToAllNoSheriff    string
+	ToSheriffPass     string
+	ToAllBadgePassed  string
+	ToAllBadgeTorn    string
+	ToRebuttal        string
+	ToAllRebuttal     string
+	ToAllLastWords    string
+	ToAllClaims       string
+	ToBelief          string
+
+	// 目标校验
+	ToIllegalTarget   string
+	ToIllegalMissing  string
+	ToIllegalUnknown  string
+	ToIllegalDead     string
+	ToIllegalSelf     string
+	ToIllegalTeammate string
+	ToIllegalNoPotion string
+	ToIllegalNoKill   string
+
+	// 解说（不进入任何玩家的消息历史）
+	CommentatorSystem string
+	ToCommentator     string
+
+	// 跨局经验
+	ToLessons          string
+	ToSummarizeLessons string
 
 	// 游戏结束
 	ToAllWolfWin    string
 	ToAllVillageWin string
 	ToAllContinue   string
 	ToAllReflect    string
+	ToDeadCatchUp   string
 }
 
-// Prompts 当前使用的提示词模板
-var Prompts = ChinesePrompts
-
 // ChinesePrompts 中文游戏提示词模板
 var ChinesePrompts = PromptsTemplate{
 	BaseSystem: `你是一个狼人杀游戏玩家，名字是 %s。
@@ -81,7 +126,7 @@ var ChinesePrompts = PromptsTemplate{
 尽可能与队友一起赢得游戏。
 
 # 游戏规则
-- 狼人杀游戏中，玩家分为三个狼人、三个村民、一个预言家、一个猎人和一个女巫。
+- 狼人杀游戏中，本局玩家分为：%s。
     - 狼人：每晚杀死一名玩家，白天必须隐藏身份。
     - 村民：没有特殊能力的普通玩家，尝试识别并淘汰狼人。
         - 预言家：特殊村民，每晚可以查验一名玩家的身份。
@@ -126,9 +171,19 @@ var ChinesePrompts = PromptsTemplate{
 3. 提出你的建议和具体理由
 4. 如果同意队友的建议，说明原因并补充策略
 
-如果达成一致，请将 reach_agreement 设为 True。`,
-	ToWolvesVote: "[仅狼人可见] 你投票要杀死哪位玩家？",
-	ToWolvesRes:  "[仅狼人可见] 投票结果为 %s，你们选择淘汰 %s。",
+请调用 discuss 工具：在 target 中给出你提议击杀的玩家，在 message 中说明理由。所有狼人的当前提议相同即达成一致，该玩家就是今晚的击杀目标。`,
+	ToWolvesProposals:  "[仅狼人可见] 当前各狼人的提议：%s",
+	ToWolvesHistory:    "\n\n[之前的讨论]:\n%s",
+	ToHistoryModerator: "主持人: %s\n",
+	ToHistorySelf:      "你: %s\n",
+	ToWolvesRes:        "[仅狼人可见] 协商结果为 %s，你们选择淘汰 %s。",
+	ToWolvesAgreed:     "全体一致",
+	ToWolvesLeader:     "意见不一致，由狼首 %s 决定",
+	ToWolvesMajority:   "意见不一致，按多数提议决定",
+	ToWolvesRandom:     "意见不一致，从提议中随机决定",
+	ToWolvesNoKill:     "[仅狼人可见] 协商结果为 %s，你们选择今晚空刀，不淘汰任何人。",
+	ToWolvesEmptyKill:  "\n\n本局允许空刀：如果你认为今晚不杀人更有利，可以在 target 中填写 none。",
+	ToWolvesSelfKnife:  "\n\n本局允许自刀：你们也可以提议击杀一名狼人同伴，借女巫的解药或白天的身份做文章。",
 
 	// 女巫相关
 	ToAllWitchTurn:      "轮到女巫行动，女巫请睁眼并决定今晚的操作...",
@@ -147,17 +202,57 @@ var ChinesePrompts = PromptsTemplate{
 	ToAllHunterShoot: "猎人选择带走 %s 一起出局。",
 
 	// 白天阶段
-	ToAllDay:     "天亮了，请所有玩家睁眼。昨晚被淘汰的玩家有：%s。",
-	ToAllPeace:   "天亮了，请所有玩家睁眼。昨晚平安夜，无人被淘汰。",
-	ToAllDiscuss: "现在存活玩家有：%s。游戏继续，大家开始讨论并投票淘汰一名玩家。请按顺序（%s）依次发言。",
-	ToAllVote:    "讨论结束。请大家从存活玩家中投票淘汰一人：%s。",
-	ToAllRes:     "投票结果为 %s，%s 被淘汰。",
+	ToAllDay:      "天亮了，请所有玩家睁眼。昨晚被淘汰的玩家有：%s。",
+	ToAllPeace:    "天亮了，请所有玩家睁眼。昨晚平安夜，无人被淘汰。",
+	ToAllDiscuss:  "现在存活玩家有：%s。游戏继续，大家开始讨论并投票淘汰一名玩家。请按顺序（%s）依次发言。",
+	ToAllVote:     "讨论结束。请大家从存活玩家中投票淘汰一人：%s。如果不想投给任何人，可以调用 vote 工具并将 abstain 设为 true 来弃票。",
+	ToAllRes:      "投票结果为 %s，%s 被淘汰。",
+	ToAllResNone:  "投票结果为 %s。有效票 %d 张，淘汰至少需要 %d 张有效票，本轮无人被淘汰。",
+	ToVoteAbstain: "弃票：%s",
+	ToVoteSpoiled: "废票：%s",
+	ToVoteTie:     "%s 平票，随机抽中 %s",
+
+	// 讨论策略
+	ToAllDiscussRound: "第 %d 轮讨论开始，发言顺序为：%s。",
+	ToPlayerSpeak:     "轮到你发言了，请分析局势并表达你的观点。",
+	ToSpeechTool:      "如果要声明身份、公布查验结果或表明怀疑和信任的玩家，可以先调用 speech 工具（可选），再给出完整发言。",
+	ToSheriffOrder:    "[仅警长可见] %s，你是警长，请调用 direction 工具决定今天的发言方向：顺时针（clockwise）或逆时针（counterclockwise）。你将最后一个发言。",
+	ToSheriffElection: "发言前先选出警长。警长每天决定发言方向并最后发言，出局时可以移交警徽。当前存活玩家有：%s。请在 target 中给出你支持当选警长的玩家（不能选自己），并说明理由。",
+	ToAllSheriff:      "警长竞选结果为 %s，%s 当选警长。",
+	ToAllNoSheriff:    "警长竞选结果为 %s，没有有效票，本局没有警长。",
+	ToSheriffPass:     "[仅警长可见] %s，你是警长并且已经出局。请在 target 中给出接任警长的存活玩家；不给出目标即撕掉警徽，本局不再有警长。当前存活玩家有：%s。",
+	ToAllBadgePassed:  "警长 %s 把警徽移交给了 %s。",
+	ToAllBadgeTorn:    "警长 %s 撕掉了警徽，本局不再有警长。",
+	ToRebuttal:        "反驳环节：以下玩家在发言中怀疑或点名了你：\n%s\n你可以针对这些指控进行回应。",
+	ToAllRebuttal:     "[%s 反驳]: %s",
+	ToAllLastWords:    "[%s 遗言]: %s",
+	ToAllClaims:       "[主持人] 投票前汇总目前场上的公开声明：\n%s",
+	ToBelief:          "[仅你可见] 请调用 belief 工具，给出你认为以下每名玩家是狼人的概率（0 到 1）：%s。这只用于评估你的判断，不会告诉其他玩家。",
+
+	// 目标校验
+	ToIllegalTarget:   "%s 不是合法的目标：%s。可选的目标有：%s。请重新选择，并再次调用相应的工具。",
+	ToIllegalMissing:  "没有给出目标",
+	ToIllegalUnknown:  "该玩家不在本局游戏中",
+	ToIllegalDead:     "该玩家已经出局",
+	ToIllegalSelf:     "不能选择自己",
+	ToIllegalTeammate: "不能击杀同伴狼人",
+	ToIllegalNoPotion: "药水已经用完",
+	ToIllegalNoKill:   "本局不允许空刀",
+
+	// 解说
+	CommentatorSystem: "你是一场 AI 狼人杀比赛的解说员，面向观众，掌握所有玩家的真实身份。每个阶段结束后，用 3 到 5 句话点评局势：谁处境危险、哪些身份声明或查验是假的、哪一方占据优势以及接下来的看点。语言简洁生动，不要复述全部过程。",
+	ToCommentator:     "%s刚刚结束。\n\n上帝视角的场上状态：\n%s\n\n本阶段发生的事件：\n%s\n\n请给出你的解说。",
+
+	// 跨局经验
+	ToLessons:          "# 往届对局的经验\n以下是你在之前对局中担任该角色时总结的经验，可供参考：\n%s",
+	ToSummarizeLessons: "以下是 AI 玩家在多局狼人杀中担任%s后写下的经验，[win] 表示该局获胜，[loss] 表示失败：\n%s\n请归纳出最多 %d 条最有价值、可以直接执行的经验，优先保留获胜局中反复出现的做法，合并重复的内容。每行一条，不要编号，不要输出其他内容。",
 
 	// 游戏结束
 	ToAllWolfWin:    "当前存活玩家共%d人，其中%d人为狼人。游戏结束，狼人获胜🐺🎉！本局所有玩家真实身份为：%s",
 	ToAllVillageWin: "所有狼人已被淘汰。游戏结束，村民获胜🏘️🎉！本局所有玩家真实身份为：%s",
 	ToAllContinue:   "游戏继续。",
-	ToAllReflect:    "游戏结束。现在每位玩家可以对自己的表现进行反思。注意每位玩家只有一次发言机会，且反思内容仅自己可见。",
+	ToAllReflect:    "游戏结束。现在每位玩家可以对自己的表现进行反思。注意每位玩家只有一次发言机会，且反思内容仅自己可见。最后请用一行以“经验：”开头的话，总结下次担任同一角色时可以借鉴的一条经验。",
+	ToDeadCatchUp:   "你出局后场上发生了以下事情（共 %d 条消息，已截断）：\n%s",
 }
 
 // EnglishPrompts 英文游戏提示词模板
@@ -168,7 +263,7 @@ var EnglishPrompts = PromptsTemplate{
 Your target is to win the game with your teammates as much as possible.
 
 # GAME RULES
-- In werewolf game, players are divided into three werewolves, three villagers, one seer, one hunter and one witch.
+- In this werewolf game, players are divided into: %s.
     - Werewolves: kill one player each night, and must hide identity during the day.
     - Villagers: ordinary players without special abilities, try to identify and eliminate werewolves.
         - Seer: A special villager who can check one player's identity each night.
@@ -213,9 +308,19 @@ Discussion points:
 3. Propose your suggestion with specific reasons
 4. If you agree with teammates, explain why and add strategy tips
 
-Set reach_agreement to True when you reach consensus.`,
-	ToWolvesVote: "[WEREWOLVES ONLY] Which player do you vote to kill?",
-	ToWolvesRes:  "[WEREWOLVES ONLY] The voting result is %s. So you have chosen to eliminate %s.",
+Call the discuss tool: put the player you propose to kill in target and explain your reason in message. You reach agreement when all werewolves' current proposals are the same, and that player is tonight's kill.`,
+	ToWolvesProposals:  "[WEREWOLVES ONLY] Current proposals: %s",
+	ToWolvesHistory:    "\n\n[Previous discussion]:\n%s",
+	ToHistoryModerator: "Moderator: %s\n",
+	ToHistorySelf:      "You: %s\n",
+	ToWolvesRes:        "[WEREWOLVES ONLY] The negotiation result is %s. So you have chosen to eliminate %s.",
+	ToWolvesAgreed:     "unanimous",
+	ToWolvesLeader:     "no agreement, decided by the wolf leader %s",
+	ToWolvesMajority:   "no agreement, decided by the most proposed target",
+	ToWolvesRandom:     "no agreement, picked at random from the proposals",
+	ToWolvesNoKill:     "[WEREWOLVES ONLY] The negotiation result is %s. So you have chosen not to kill anyone tonight.",
+	ToWolvesEmptyKill:  "\n\nEmpty kills are allowed in this game: if you think killing nobody tonight serves you better, put none in target.",
+	ToWolvesSelfKnife:  "\n\nSelf-knifing is allowed in this game: you may also propose to kill a fellow werewolf, to bait the witch's potion or build a daytime story.",
 
 	// 女巫相关
 	ToAllWitchTurn:      "Witch's turn, witch open your eyes and decide your action tonight...",
@@ -234,17 +339,194 @@ Set reach_agreement to True when you reach consensus.`,
 	ToAllHunterShoot: "The hunter has chosen to shoot %s down with him/herself.",
 
 	// 白天阶段
-	ToAllDay:     "The day is coming, all players open your eyes. Last night, the following player(s) has been eliminated: %s.",
-	ToAllPeace:   "The day is coming, all the players open your eyes. Last night is peaceful, no player is eliminated.",
-	ToAllDiscuss: "Now the alive players are %s. The game goes on, it's time to discuss and vote a player to be eliminated. Now you each take turns to speak once in the order of %s.",
-	ToAllVote:    "Now the discussion is over. Everyone, please vote to eliminate one player from the alive players: %s.",
-	ToAllRes:     "The voting result is %s. So %s has been voted out.",
+	ToAllDay:      "The day is coming, all players open your eyes. Last night, the following player(s) has been eliminated: %s.",
+	ToAllPeace:    "The day is coming, all the players open your eyes. Last night is peaceful, no player is eliminated.",
+	ToAllDiscuss:  "Now the alive players are %s. The game goes on, it's time to discuss and vote a player to be eliminated. Now you each take turns to speak once in the order of %s.",
+	ToAllVote:     "Now the discussion is over. Everyone, please vote to eliminate one player from the alive players: %s. If you do not want to vote for anyone, call the vote tool with abstain set to true.",
+	ToAllRes:      "The voting result is %s. So %s has been voted out.",
+	ToAllResNone:  "The voting result is %s. There are %d valid votes and at least %d are needed for an elimination, so nobody is voted out this round.",
+	ToVoteAbstain: "abstained: %s",
+	ToVoteSpoiled: "spoiled: %s",
+	ToVoteTie:     "tie between %s, %s drawn at random",
+
+	// 讨论策略
+	ToAllDiscussRound: "Discussion round %d begins, the speaking order is %s.",
+	ToPlayerSpeak:     "It's your turn to speak. Analyze the situation and share your opinion.",
+	ToSpeechTool:      " If you want to claim a role, reveal check results, or name the players you suspect or trust, you may first call the speech tool (optional), then give your full speech.",
+	ToSheriffOrder:    "[SHERIFF ONLY] %s, you're the sheriff. Call the direction tool to decide today's speaking direction: clockwise or counterclockwise. You will speak last.",
+	ToSheriffElection: "Before the discussion, you need to elect a sheriff. The sheriff decides the speaking direction every day, speaks last, and can pass the badge on when eliminated. Current alive players are %s. Put the player you support as sheriff in target (you cannot choose yourself) and give your reason.",
+	ToAllSheriff:      "The sheriff election result is %s. %s is elected sheriff.",
+	ToAllNoSheriff:    "The sheriff election result is %s. There are no valid votes, so there is no sheriff in this game.",
+	ToSheriffPass:     "[SHERIFF ONLY] %s, you're the sheriff and you have been eliminated. Put the alive player who takes over as sheriff in target; give no target to tear up the badge, and there will be no sheriff for the rest of the game. Current alive players are %s.",
+	ToAllBadgePassed:  "Sheriff %s passed the badge to %s.",
+	ToAllBadgeTorn:    "Sheriff %s tore up the badge. There is no sheriff for the rest of the game.",
+	ToRebuttal:        "Rebuttal: the following players suspected or named you in their speeches:\n%s\nYou may now respond to these accusations.",
+	ToAllRebuttal:     "[%s rebuttal]: %s",
+	ToAllLastWords:    "[%s last words]: %s",
+	ToAllClaims:       "[Moderator] Before voting, here is a summary of the public claims so far:\n%s",
+	ToBelief:          "[ONLY YOU] Please call the belief tool and give the probability (0 to 1) that each of these players is a werewolf: %s. This is only used to evaluate your judgement and will not be shown to other players.",
+
+	// 目标校验
+	ToIllegalTarget:   "%s is not a valid target: %s. Valid targets: %s. Please choose again and call the corresponding tool again.",
+	ToIllegalMissing:  "no target was given",
+	ToIllegalUnknown:  "there is no such player in this game",
+	ToIllegalDead:     "that player has already been eliminated",
+	ToIllegalSelf:     "you cannot choose yourself",
+	ToIllegalTeammate: "werewolves cannot kill a fellow werewolf",
+	ToIllegalNoPotion: "the potion has already been used",
+	ToIllegalNoKill:   "empty kills are not allowed in this game",
+
+	// Commentary
+	CommentatorSystem: "You are the commentator of an AI werewolf match, speaking to the audience with knowledge of every player's true role. After each phase, comment on the game in 3 to 5 sentences: who is in danger, which role claims or checks are fake, which side has the momentum and what to watch next. Keep it short and lively; do not retell everything.",
+	ToCommentator:     "%s has just ended.\n\nGod's-eye view of the game:\n%s\n\nEvents in this phase:\n%s\n\nPlease give your commentary.",
+
+	// Cross-game lessons
+	ToLessons:          "# LESSONS FROM PREVIOUS GAMES\nHere are lessons you wrote in previous games when playing this role, for your reference:\n%s",
+	ToSummarizeLessons: "Below are lessons written by AI players after playing %s in several werewolf games; [win] means that game was won and [loss] means it was lost:\n%s\nCondense them into at most %d of the most valuable, directly actionable lessons, favouring practices that recur in won games and merging duplicates. One lesson per line, no numbering, nothing else.",
 
 	// 游戏结束
 	ToAllWolfWin:    "There are %d players alive, and %d of them are werewolves. The game is over and werewolves win🐺🎉!In this game, the true roles of all players are: %s",
 	ToAllVillageWin: "All the werewolves have been eliminated.The game is over and villagers win🏘️🎉!In this game, the true roles of all players are: %s",
 	ToAllContinue:   "The game goes on.",
-	ToAllReflect:    "The game is over. Now each player can reflect on their performance. Note each player only has one chance to speak and the reflection is only visible to themselves.",
+	ToAllReflect:    "The game is over. Now each player can reflect on their performance. Note each player only has one chance to speak and the reflection is only visible to themselves. Finish with one line starting with \"Lesson:\" that states a lesson to apply the next time you play the same role.",
+	ToDeadCatchUp:   "Here is what happened after you were eliminated (%d messages, truncated):\n%s",
+}
+
+// JapanesePrompts 日文游戏提示词模板
+var JapanesePrompts = PromptsTemplate{
+	BaseSystem: `あなたは人狼ゲームのプレイヤーで、名前は %s です。
+
+# あなたの目標
+仲間と協力して、できる限りゲームに勝利してください。
+
+# ゲームルール
+- このゲームのプレイヤーは、%sで構成されます。
+    - 人狼：毎晩プレイヤーを1人襲撃し、昼は正体を隠さなければなりません。
+    - 村人：特殊能力を持たない一般プレイヤーで、人狼を見つけ出して追放することを目指します。
+        - 占い師：毎晩1人のプレイヤーの正体を占える特殊な村人です。
+        - 魔女：1回限りの薬を2つ持つ特殊な村人です。解毒薬は人狼に襲撃されたプレイヤーを救い、毒薬は1人のプレイヤーを毒殺できます。
+        - 狩人：追放されたときに1人のプレイヤーを道連れにできる特殊な村人です。
+- ゲームはどちらかの陣営が勝利するまで、夜と昼を交互に繰り返します：
+    - 夜フェーズ：人狼が犠牲者を1人選び、占い師が1人を占い、魔女が薬を使うかどうかを決めます
+    - 昼フェーズ：全員で議論し、疑わしいプレイヤーを1人投票で追放します
+
+# ゲームの指針
+- 仲間と協力して勝利を目指してください。駆け引き、嘘、偽装はすべて許されています。
+- 議論では遠回しな言い方をせず、要点を直接述べてください。
+- 昼の投票結果は重要な手がかりになります。
+
+# あなたの役職
+あなたは %s です。
+%s
+
+# 注意
+- [重要] 司会や他のプレイヤーが提供していない情報をでっち上げないでください。
+- これは文字だけのゲームです。文字以外の情報を使ったり作り出したりしないでください。
+- 自分の根拠が本当に存在するかを常に批判的に見直し、思い込みを避けてください。
+- 回答は具体的かつ簡潔にし、明確な理由を示し、不要な説明は避けてください。
+- 1行で回答してください。
+- 他のプレイヤーの発言を繰り返さないでください。`,
+
+	// 死亡相关
+	ToDeadPlayer: "%s、あなたは脱落しました。ゲームを去る前に、生存しているプレイヤー全員に遺言を残すことができます。",
+
+	// 游戏开始
+	ToAllNewGame: "新しいゲームを開始します。参加プレイヤーは %s です。これから各プレイヤーに役職をランダムに配り、個別にお知らせします。",
+
+	// 夜晚阶段
+	ToAllNight: "夜になりました。全員目を閉じてください。人狼は目を開けて、今夜襲撃するプレイヤーを選んでください...",
+
+	// 狼人相关
+	ToWolvesDiscussion: `[人狼のみ] %s、今夜襲撃するプレイヤーを相談して決めてください。現在の生存プレイヤーは %s です。
+
+相談のポイント：
+1. どのプレイヤーが特殊な役職（占い師、魔女、狩人）の可能性があるか分析する
+2. 疑いを避けるため、目立たない位置のプレイヤーを選ぶことも検討する
+3. 具体的な理由とともに提案する
+4. 仲間の提案に賛成する場合は、その理由と補足の作戦を述べる
+
+discuss ツールを呼び出し、target に襲撃を提案するプレイヤーを、message に理由を書いてください。全員の現在の提案が同じになれば合意となり、そのプレイヤーが今夜の襲撃先になります。`,
+	ToWolvesProposals:  "[人狼のみ] 現在の各人狼の提案：%s",
+	ToWolvesHistory:    "\n\n[これまでの話し合い]:\n%s",
+	ToHistoryModerator: "司会: %s\n",
+	ToHistorySelf:      "あなた: %s\n",
+	ToWolvesRes:        "[人狼のみ] 相談の結果は %s で、%s を襲撃することに決まりました。",
+	ToWolvesAgreed:     "全員一致",
+	ToWolvesLeader:     "意見が割れたため、リーダーの %s が決定",
+	ToWolvesMajority:   "意見が割れたため、最も多い提案で決定",
+	ToWolvesRandom:     "意見が割れたため、提案の中からランダムに決定",
+	ToWolvesNoKill:     "[人狼のみ] 相談の結果は %s で、今夜は誰も襲撃しないことに決まりました。",
+	ToWolvesEmptyKill:  "\n\nこのゲームでは襲撃なしが認められています：今夜誰も襲撃しない方が有利だと考えるなら、target に none と書いてください。",
+	ToWolvesSelfKnife:  "\n\nこのゲームでは身内切りが認められています：魔女の薬を誘ったり昼の主張に使ったりするため、人狼の仲間の襲撃を提案することもできます。",
+
+	// 女巫相关
+	ToAllWitchTurn:      "魔女の番です。魔女は目を開けて、今夜の行動を決めてください...",
+	ToWitchResurrect:    "[魔女のみ] %s、あなたは魔女です。今夜 %s が襲撃されました。解毒薬で救うことができますが、解毒薬はゲーム中1回しか使えません。%s を救いますか？理由と決定を述べてください。",
+	ToWitchResurrectNo:  "[魔女のみ] 魔女はこのプレイヤーを救わないことを選びました。",
+	ToWitchResurrectYes: "[魔女のみ] 魔女はこのプレイヤーを救うことを選びました。",
+	ToWitchPoison:       "[魔女のみ] %s、あなたは1回限りの毒薬を持っています。今夜使いますか？理由と決定を述べてください。",
+
+	// 预言家相关
+	ToAllSeerTurn: "占い師の番です。占い師は目を開けて、1人のプレイヤーを占ってください...",
+	ToSeer:        "[占い師のみ] %s、あなたは占い師です。今夜1人のプレイヤーの正体を占えます。誰を占いますか？理由と決定を述べてください。",
+	ToSeerResult:  "[占い師のみ] あなたは %s を占いました。結果は %s です。",
+
+	// 猎人相关
+	ToHunter:         "[狩人のみ] %s、あなたは狩人で、脱落しました。1人のプレイヤーを道連れにすることも、しないこともできます。理由と決定を述べてください。",
+	ToAllHunterShoot: "狩人は %s を道連れにすることを選びました。",
+
+	// 白天阶段
+	ToAllDay:      "朝になりました。全員目を開けてください。昨夜脱落したプレイヤーは %s です。",
+	ToAllPeace:    "朝になりました。全員目を開けてください。昨夜は平和な夜で、脱落者はいません。",
+	ToAllDiscuss:  "現在の生存プレイヤーは %s です。ゲームを続けます。議論して、追放するプレイヤーを1人投票で決めてください。(%s) の順番で発言してください。",
+	ToAllVote:     "議論は終了です。生存プレイヤーの中から追放する1人に投票してください：%s。誰にも投票したくない場合は、vote ツールで abstain を true にして棄権できます。",
+	ToAllRes:      "投票結果は %s で、%s が追放されました。",
+	ToAllResNone:  "投票結果は %s です。有効票は %d 票で、追放には少なくとも %d 票の有効票が必要なため、今回は誰も追放されません。",
+	ToVoteAbstain: "棄権：%s",
+	ToVoteSpoiled: "無効票：%s",
+	ToVoteTie:     "%s が同票、抽選で %s に決定",
+
+	// 讨论策略
+	ToAllDiscussRound: "第 %d 巡の議論を始めます。発言順は %s です。",
+	ToPlayerSpeak:     "あなたの発言の番です。状況を分析して意見を述べてください。",
+	ToSpeechTool:      "役職の宣言、占い結果の公開、疑っている・信頼しているプレイヤーの表明をする場合は、先に speech ツールを呼び出してから（任意）発言全体を述べてください。",
+	ToSheriffOrder:    "[警長のみ] %s、あなたは警長です。direction ツールで今日の発言方向を決めてください：時計回り（clockwise）または反時計回り（counterclockwise）。あなたは最後に発言します。",
+	ToSheriffElection: "議論の前に警長を選びます。警長は毎日発言方向を決めて最後に発言し、脱落したときはバッジを引き継がせることができます。現在の生存プレイヤーは %s です。警長に推すプレイヤーを target に書き（自分は選べません）、理由を述べてください。",
+	ToAllSheriff:      "警長選挙の結果は %s で、%s が警長に選ばれました。",
+	ToAllNoSheriff:    "警長選挙の結果は %s です。有効票がないため、このゲームに警長はいません。",
+	ToSheriffPass:     "[警長のみ] %s、あなたは警長で、脱落しました。警長を引き継ぐ生存プレイヤーを target に書いてください。対象を書かなければバッジは破棄され、以降警長はいなくなります。現在の生存プレイヤーは %s です。",
+	ToAllBadgePassed:  "警長 %s がバッジを %s に引き継ぎました。",
+	ToAllBadgeTorn:    "警長 %s がバッジを破棄しました。以降警長はいません。",
+	ToRebuttal:        "反論タイム：次のプレイヤーが発言であなたを疑うか名指ししました：\n%s\nこれらの指摘に反論できます。",
+	ToAllRebuttal:     "[%s の反論]: %s",
+	ToAllLastWords:    "[%s の遺言]: %s",
+	ToAllClaims:       "[司会] 投票の前に、これまでの公開された主張をまとめます：\n%s",
+	ToBelief:          "[あなたのみ] belief ツールを呼び出し、次の各プレイヤーが人狼である確率（0〜1）を答えてください：%s。これはあなたの判断を評価するためだけに使われ、他のプレイヤーには伝えられません。",
+
+	// 目标校验
+	ToIllegalTarget:   "%s は有効な対象ではありません：%s。選べる対象：%s。もう一度選び直し、対応するツールを呼び出してください。",
+	ToIllegalMissing:  "対象が指定されていません",
+	ToIllegalUnknown:  "このゲームに参加していないプレイヤーです",
+	ToIllegalDead:     "そのプレイヤーは既に脱落しています",
+	ToIllegalSelf:     "自分自身は選べません",
+	ToIllegalTeammate: "仲間の人狼は襲撃できません",
+	ToIllegalNoPotion: "その薬は既に使用済みです",
+	ToIllegalNoKill:   "このゲームでは襲撃なしは認められていません",
+
+	// 実況
+	CommentatorSystem: "あなたは AI 人狼ゲームの実況者で、観客に向けて話します。全プレイヤーの本当の役職を知っています。各フェーズの後、3〜5 文で状況を解説してください：誰が危ないか、どの役職宣言や占い結果が偽物か、どちらの陣営が優勢か、次の見どころは何か。簡潔に生き生きと、経過をすべて繰り返さないでください。",
+	ToCommentator:     "%sが終わりました。\n\n神視点の盤面：\n%s\n\nこのフェーズの出来事：\n%s\n\n実況をお願いします。",
+
+	// 過去の教訓
+	ToLessons:          "# 過去のゲームからの教訓\n以前この役職を担当したときにまとめた教訓です。参考にしてください：\n%s",
+	ToSummarizeLessons: "以下は AI プレイヤーが複数の人狼ゲームで%sを担当した後に書いた教訓です。[win] はその回の勝利、[loss] は敗北を表します：\n%s\n最も価値があり、すぐに実行できる教訓を最大 %d 個にまとめてください。勝った回で繰り返し現れるやり方を優先し、重複は統合してください。1 行に 1 つ、番号なし、他には何も出力しないでください。",
+
+	// 游戏结束
+	ToAllWolfWin:    "生存プレイヤーは %d 人で、そのうち %d 人が人狼です。ゲーム終了、人狼の勝利です🐺🎉！今回の全プレイヤーの本当の役職は：%s",
+	ToAllVillageWin: "人狼は全員追放されました。ゲーム終了、村人の勝利です🏘️🎉！今回の全プレイヤーの本当の役職は：%s",
+	ToAllContinue:   "ゲームを続けます。",
+	ToAllReflect:    "ゲーム終了です。各プレイヤーは自分のプレイを振り返ってください。発言の機会は1回だけで、振り返りの内容は自分にしか見えません。最後に「教訓：」で始まる 1 行で、次に同じ役職を担当するときに活かせる教訓をまとめてください。",
+	ToDeadCatchUp:   "あなたが脱落した後に起きたこと（%d 件のメッセージ、一部省略）：\n%s",
 }
 
 // RoleGuidance 角色指导
@@ -255,6 +537,11 @@ var RoleGuidance = map[game.Role]string{
 - 假装成其他角色（预言家、女巫或村民）是隐藏身份和在白天误导其他村民的常见策略。
 - 夜晚阶段的结果提供重要线索。例如，女巫是否使用了解药或毒药，死者是否是猎人等。利用这些信息调整你的策略。`,
 
+	game.RoleHiddenWolf: `## 隐狼游戏指导
+- 你属于狼人阵营，夜里和其他狼人一起讨论并决定击杀目标，也知道队友是谁。
+- 预言家查验你时会得到好人的结果，这是你最大的优势。被查验为好人后可以更大胆地替队友说话，但不要暴露出你知道谁是狼人。
+- 队友被查杀时，不要急于为他辩护，必要时可以跟着投票以保全自己，留到最后帮助狼人阵营获胜。`,
+
 	game.RoleVillager: `## 村民游戏指导
 - 保护特殊村民，尤其是预言家，对你方的成功至关重要。
 - 狼人可能假装成预言家。保持警惕，不要轻易相信任何人。
@@ -275,9 +562,3 @@ var RoleGuidance = map[game.Role]string{
 - 你的开枪能力在你被淘汰时激活（被女巫毒死除外）。
 - 在讨论中表现得像普通村民，避免被盯上。`,
 }
-
-// BuildPlayerInstruction 构建玩家系统提示
-func BuildPlayerInstruction(name string, role game.Role) string {
-	guidance := RoleGuidance[role]
-	return fmt.Sprintf(Prompts.BaseSystem, name, role, guidance)
-}
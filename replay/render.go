@@ -0,0 +1,155 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+// Render 输出一步回放
+func Render(w io.Writer, step Step, text *game.LogText) {
+	switch {
+	case step.Round == 0:
+		fmt.Fprintln(w, text.ReplaySetup)
+	case step.Phase == "":
+		fmt.Fprintf(w, text.ReplayRound+"\n", step.Round)
+	default:
+		fmt.Fprintf(w, text.ReplayStep+"\n", step.Round, step.Phase)
+	}
+	for _, e := range step.Events {
+		if line := describe(e, text); line != "" {
+			fmt.Fprintln(w, line)
+		}
+	}
+}
+
+// Header 返回视角标题
+func Header(seat string, roles map[string]game.Role, text *game.LogText) string {
+	if seat == "" {
+		return text.ReplayGodView
+	}
+	return fmt.Sprintf(text.ReplaySeatView, seat, roleName(roles[seat], text))
+}
+
+// describe 把事件渲染为一行（开局和结束可能有多行），不需要展示的事件返回空
+func describe(e game.Event, t *game.LogText) string {
+	switch e.Type {
+	case game.EventGameStart:
+		lines := []string{t.ReplayRoleAssignment}
+		for _, p := range sortedSeats(e.Roles) {
+			lines = append(lines, fmt.Sprintf("- %s: %s", p, roleName(e.Roles[p], t)))
+		}
+		return strings.Join(lines, "\n")
+	case game.EventModeratorMsg:
+		return fmt.Sprintf(t.ReplayModerator, e.Content)
+	case game.EventWolfSpeech:
+		if e.Target != "" {
+			return fmt.Sprintf(t.ReplayWolfProposal, e.Actor, e.Target, e.Content)
+		}
+		return fmt.Sprintf(t.ReplayWolfSpeech, e.Actor, e.Content)
+	case game.EventWolfVote:
+		return fmt.Sprintf(t.ReplayWolfVote, e.Actor, e.Target)
+	case game.EventWolfKill:
+		if e.Target == "" {
+			if e.Content != "" {
+				return t.ReplayWolfNoKill
+			}
+			return ""
+		}
+		return fmt.Sprintf(t.ReplayWolfKill, e.Target)
+	case game.EventSeerCheck:
+		if e.Faction == "" {
+			// 旧日志只记录了身份
+			return fmt.Sprintf(t.ReplaySeerCheck, e.Target, roleName(game.Role(e.Content), t))
+		}
+		return fmt.Sprintf(t.ReplaySeerCheck, e.Target, t.CheckResultName(game.CheckResult{Faction: e.Faction, Role: game.Role(e.Content)}))
+	case game.EventWitchSave:
+		return fmt.Sprintf(t.ReplayWitchSave, e.Target)
+	case game.EventWitchPoison:
+		return fmt.Sprintf(t.ReplayWitchPoison, e.Target)
+	case game.EventDeath:
+		if cause, ok := t.DeathCauses[e.Content]; ok {
+			return fmt.Sprintf(t.ReplayDeath, e.Target, cause)
+		}
+		return fmt.Sprintf(t.ReplayDeathUnknown, e.Target)
+	case game.EventSpeech:
+		return fmt.Sprintf(t.ReplaySpeech, e.Actor, e.Content)
+	case game.EventBelief:
+		return fmt.Sprintf(t.ReplayBelief, e.Actor, formatBeliefs(e.Beliefs))
+	case game.EventVote:
+		return fmt.Sprintf(t.ReplayVote, e.Actor, e.Target)
+	case game.EventAbstain:
+		return fmt.Sprintf(t.ReplayAbstain, e.Actor)
+	case game.EventSpoiled:
+		return fmt.Sprintf(t.ReplaySpoiled, e.Actor, t.SpoiledReason(e.Content))
+	case game.EventVoteResult:
+		return fmt.Sprintf(t.ReplayVoteResult, e.Content)
+	case game.EventLastWords:
+		return fmt.Sprintf(t.ReplayLastWords, e.Actor, e.Content)
+	case game.EventHunterShoot:
+		return fmt.Sprintf(t.ReplayHunterShoot, e.Target)
+	case game.EventGameOver:
+		lines := []string{fmt.Sprintf(t.ReplaySurvivors, e.Content)}
+		if e.Winner != "" {
+			lines = append([]string{fmt.Sprintf(t.ReplayWinner, t.FactionNames[e.Winner])}, lines...)
+		}
+		return strings.Join(lines, "\n")
+	case game.EventReflection:
+		return fmt.Sprintf(t.ReplayReflection, e.Actor, e.Content)
+	case game.EventCommentary:
+		return fmt.Sprintf(t.ReplayCommentary, e.Content)
+	}
+	// 结构化声明已经包含在主持人的声明汇总中
+	return ""
+}
+
+// roleName 返回角色的本地化名称，未知角色原样返回
+func roleName(role game.Role, t *game.LogText) string {
+	if name, ok := t.RoleNames[role]; ok {
+		return name
+	}
+	return string(role)
+}
+
+// formatBeliefs 按座位顺序格式化概率判断
+func formatBeliefs(probs map[string]float64) string {
+	parts := make([]string, 0, len(probs))
+	for _, p := range sortedSeats(probs) {
+		parts = append(parts, fmt.Sprintf("%s %.2f", p, probs[p]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// sortedSeats 按座位号排序（Player2 排在 Player10 之前）
+func sortedSeats[V any](m map[string]V) []string {
+	seats := make([]string, 0, len(m))
+	for p := range m {
+		seats = append(seats, p)
+	}
+	sort.Slice(seats, func(i, j int) bool {
+		if len(seats[i]) != len(seats[j]) {
+			return len(seats[i]) < len(seats[j])
+		}
+		return seats[i] < seats[j]
+	})
+	return seats
+}
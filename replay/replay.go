@@ -0,0 +1,140 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package replay 根据 events.jsonl 在终端逐阶段回放已结束的对局，支持上帝视角和单个座位的视角
+package replay
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+)
+
+// Options 回放选项
+type Options struct {
+	Seat   string // 座位视角，为空时为上帝视角
+	Player string // 只显示与该玩家相关的事件，为空时不过滤
+}
+
+// Step 回放的一步：开局，或某个回合中的一个阶段
+type Step struct {
+	Round  int
+	Phase  string // 阶段名称，开局和没有阶段事件的旧日志为空
+	Events []game.Event
+}
+
+// Roles 返回开局时的角色分配
+func Roles(events []game.Event) map[string]game.Role {
+	for _, e := range events {
+		if e.Type == game.EventGameStart {
+			return e.Roles
+		}
+	}
+	return nil
+}
+
+// Steps 按视角和过滤条件把事件切分为回放步骤，没有可见事件的步骤会被略去
+func Steps(events []game.Event, opts Options) ([]Step, error) {
+	roles := Roles(events)
+	for _, seat := range []string{opts.Seat, opts.Player} {
+		if _, ok := roles[seat]; seat != "" && !ok {
+			return nil, fmt.Errorf("对局中没有玩家 %s", seat)
+		}
+	}
+
+	v := newViewer(opts.Seat, roles)
+	var steps []Step
+	cur := Step{}
+	flush := func(next Step) {
+		if len(cur.Events) > 0 {
+			steps = append(steps, cur)
+		}
+		cur = next
+	}
+	for _, e := range events {
+		switch e.Type {
+		case game.EventRound:
+			flush(Step{Round: e.Round})
+			continue
+		case game.EventPhase:
+			flush(Step{Round: e.Round, Phase: e.Content})
+			continue
+		}
+		seen, ok := v.see(e)
+		if ok && involves(seen, opts.Player) {
+			cur.Events = append(cur.Events, seen)
+		}
+	}
+	flush(Step{})
+	return steps, nil
+}
+
+// involves 判断事件是否与玩家有关，开局和结束始终保留
+func involves(e game.Event, player string) bool {
+	if player == "" || e.Type == game.EventGameStart || e.Type == game.EventGameOver {
+		return true
+	}
+	return e.Actor == player || e.Target == player
+}
+
+// Seek 返回第 round 回合的第一步，没有该回合时返回 -1
+func Seek(steps []Step, round int) int {
+	for i, s := range steps {
+		if s.Round >= round {
+			return i
+		}
+	}
+	return -1
+}
+
+// Play 从第 start 步开始输出回放
+// interactive 时每一步之后从 in 读取命令：回车下一步，b 上一步，r N 跳到第 N 回合，q 退出
+func Play(w io.Writer, in io.Reader, steps []Step, start int, text *game.LogText, interactive bool) {
+	scanner := bufio.NewScanner(in)
+	for i := start; i >= 0 && i < len(steps); {
+		Render(w, steps[i], text)
+		fmt.Fprintln(w)
+		if !interactive {
+			i++
+			continue
+		}
+
+		fmt.Fprintf(w, "%s > ", text.ReplayHelp)
+		if !scanner.Scan() {
+			return
+		}
+		fmt.Fprintln(w)
+		cmd := strings.Fields(scanner.Text())
+		switch {
+		case len(cmd) == 0:
+			i++
+		case cmd[0] == "q":
+			return
+		case cmd[0] == "b":
+			if i > 0 {
+				i--
+			}
+		case cmd[0] == "r" && len(cmd) == 2:
+			if n, err := strconv.Atoi(cmd[1]); err == nil && Seek(steps, n) >= 0 {
+				i = Seek(steps, n)
+			}
+		}
+	}
+}
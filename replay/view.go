@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import "github.com/ashwinyue/wolf-go-adk/game"
+
+// viewer 按座位还原每个事件在游戏中对谁可见
+// 需要按事件顺序调用 see，以跟踪玩家存活和女巫解药的状态
+type viewer struct {
+	seat   string // 为空时为上帝视角
+	roles  map[string]game.Role
+	alive  map[string]bool
+	healed bool // 女巫的解药已经用掉
+}
+
+func newViewer(seat string, roles map[string]game.Role) *viewer {
+	alive := make(map[string]bool, len(roles))
+	for p := range roles {
+		alive[p] = true
+	}
+	return &viewer{seat: seat, roles: roles, alive: alive}
+}
+
+// see 返回该视角看到的事件，看不到时返回 false
+// 与主持人的实际消息一致：狼人只在存活时收到夜间密谋，女巫在解药可用时得知击杀目标，夜间死亡只公布名字不公布死因
+func (v *viewer) see(e game.Event) (game.Event, bool) {
+	defer v.update(e)
+	if v.seat == "" {
+		return e, true
+	}
+
+	role := v.roles[v.seat]
+	wolf := role.IsWolf()
+	switch e.Type {
+	case game.EventGameStart:
+		// 每个人只知道自己的身份，狼人还知道队友
+		roles := make(map[string]game.Role)
+		for p, r := range e.Roles {
+			if p == v.seat || (wolf && r.IsWolf()) {
+				roles[p] = r
+			}
+		}
+		e.Roles = roles
+		return e, true
+	case game.EventWolfSpeech, game.EventWolfVote:
+		return e, wolf && v.alive[v.seat]
+	case game.EventWolfKill:
+		if wolf && v.alive[v.seat] {
+			return e, true
+		}
+		// 女巫被询问是否救人时只知道目标，不知道狼人的投票详情
+		if role == game.RoleWitch && v.alive[v.seat] && !v.healed && e.Target != "" && e.Target != v.seat {
+			e.Content = ""
+			return e, true
+		}
+		return e, false
+	case game.EventSeerCheck:
+		return e, role == game.RoleSeer
+	case game.EventWitchSave, game.EventWitchPoison:
+		return e, role == game.RoleWitch
+	case game.EventDeath:
+		known := e.Content == game.DeathVoted || e.Content == game.DeathShot ||
+			(e.Content == game.DeathKilled && wolf) ||
+			(e.Content == game.DeathPoisoned && role == game.RoleWitch)
+		if !known {
+			e.Content = ""
+		}
+		return e, true
+	case game.EventBelief, game.EventReflection, game.EventForfeit:
+		return e, e.Actor == v.seat
+	case game.EventCommentary:
+		return e, false
+	}
+	return e, true
+}
+
+// update 根据事件更新存活和药水状态
+func (v *viewer) update(e game.Event) {
+	switch e.Type {
+	case game.EventDeath:
+		v.alive[e.Target] = false
+	case game.EventWitchSave:
+		v.healed = true
+	}
+}
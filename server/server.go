@@ -0,0 +1,166 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package server 提供对局日志的 HTTP 服务，接口与前端回放使用的静态数据（web/public/data）格式相同
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/telemetry"
+)
+
+// GameSummary 对局列表中的一项
+type GameSummary struct {
+	ID     string       `json:"id"`
+	Winner game.Faction `json:"winner,omitempty"`
+	Rounds int          `json:"rounds,omitempty"`
+}
+
+// Server 对局日志服务
+type Server struct {
+	logDir    string
+	staticDir string
+}
+
+// New 创建对局日志服务，logDir 为游戏日志根目录，staticDir 不为空时同时提供前端静态文件
+func New(logDir, staticDir string) *Server {
+	if logDir == "" {
+		logDir = "logs"
+	}
+	return &Server{logDir: logDir, staticDir: staticDir}
+}
+
+// Handler 返回 HTTP 处理器
+//   - GET /data/games.json: 对局列表，按游戏 ID 倒序
+//   - GET /data/{id}.json: 对局的完整日志（full_log.md）
+//   - GET /api/games/{id}/events: 对局的结构化事件（events.jsonl）
+//   - GET /metrics: Prometheus 指标
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /data/games.json", s.handleGames)
+	mux.HandleFunc("GET /data/{file}", s.handleGame)
+	mux.HandleFunc("GET /api/games/{id}/events", s.handleEvents)
+	mux.Handle("GET /metrics", telemetry.Handler())
+	if s.staticDir != "" {
+		mux.Handle("GET /", http.FileServer(http.Dir(s.staticDir)))
+	}
+	return mux
+}
+
+// Games 列出日志根目录下所有包含完整日志的对局
+func (s *Server) Games() ([]GameSummary, error) {
+	entries, err := os.ReadDir(s.logDir)
+	if err != nil {
+		return nil, fmt.Errorf("读取日志目录失败: %w", err)
+	}
+
+	var games []GameSummary
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(s.logDir, entry.Name())
+		if _, err := os.Stat(filepath.Join(dir, "full_log.md")); err != nil {
+			continue
+		}
+		summary := GameSummary{ID: entry.Name()}
+		// 旧版日志没有 events.jsonl，只返回 ID
+		if events, err := game.LoadEvents(filepath.Join(dir, "events.jsonl")); err == nil {
+			for _, e := range events {
+				if e.Type == game.EventGameOver {
+					summary.Winner = e.Winner
+				}
+				if e.Round > summary.Rounds {
+					summary.Rounds = e.Round
+				}
+			}
+		}
+		games = append(games, summary)
+	}
+
+	sort.Slice(games, func(i, j int) bool { return games[i].ID > games[j].ID })
+	return games, nil
+}
+
+func (s *Server) handleGames(w http.ResponseWriter, r *http.Request) {
+	games, err := s.Games()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if games == nil {
+		games = []GameSummary{}
+	}
+	writeJSON(w, map[string]any{"games": games})
+}
+
+func (s *Server) handleGame(w http.ResponseWriter, r *http.Request) {
+	id, ok := strings.CutSuffix(r.PathValue("file"), ".json")
+	if !ok || !validID(id) {
+		http.NotFound(w, r)
+		return
+	}
+	content, err := os.ReadFile(filepath.Join(s.logDir, id, "full_log.md"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, map[string]string{"id": id, "content": string(content)})
+}
+
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !validID(id) {
+		http.NotFound(w, r)
+		return
+	}
+	events, err := game.LoadEvents(filepath.Join(s.logDir, id, "events.jsonl"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, events)
+}
+
+// validID 游戏 ID 只能是日志根目录下的一级目录名
+func validID(id string) bool {
+	return id != "" && id != "." && id != ".." && !strings.ContainsAny(id, `/\`)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, fs.ErrNotExist) {
+		http.NotFound(w, r)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ashwinyue/wolf-go-adk/agents/supervisor"
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/memory"
+	"github.com/ashwinyue/wolf-go-adk/params"
+	"github.com/ashwinyue/wolf-go-adk/utils"
+)
+
+// ExperimentConfig 提示词 A/B 实验配置
+type ExperimentConfig struct {
+	Experiment  *params.Experiment
+	Pairs       int                // 成对对局数，每对包含一局对照组和一局实验组
+	Concurrency int                // 同时进行的最大局数
+	Seed        int64              // 第一对对局的种子，后续依次加一；为 0 时使用当前时间
+	Board       params.BoardConfig // 板子配置
+	Locale      *params.Locale     // 基线语言包，为空时使用中文
+	LogDir      string             // 日志根目录，对照组和实验组分别写入子目录
+	Model       utils.ModelConfig  // 两组玩家使用的模型
+
+	TrackBeliefs     bool // 是否记录并评估玩家的概率判断
+	StructuredSpeech bool // 是否为玩家附加 speech 工具
+
+	// 跨局经验库，实验组或对照组设置了 lessons 时必须提供
+	// 实验期间只读取经验，不写入，避免对局之间互相影响
+	Memory *memory.Store
+}
+
+// Pair 一对使用相同种子的对局
+type Pair struct {
+	Seed      int64           `json:"seed"`
+	Control   game.GameResult `json:"control"`
+	Treatment game.GameResult `json:"treatment"`
+}
+
+// RunExperiment 成对运行对照组和实验组
+// 同一对的两局使用相同种子，因此角色分配一致；之后的随机发言顺序和平票抽签只在两局走向相同时一致
+func RunExperiment(ctx context.Context, cfg ExperimentConfig) (*ExperimentReport, error) {
+	if cfg.Experiment == nil {
+		return nil, fmt.Errorf("缺少实验定义")
+	}
+	if cfg.Pairs <= 0 {
+		return nil, fmt.Errorf("对局数必须大于 0")
+	}
+	concurrency := cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	locale := cfg.Locale
+	if locale == nil {
+		locale = params.NewLocale("")
+	}
+	if (cfg.Experiment.Control.Lessons > 0 || cfg.Experiment.Treatment.Lessons > 0) && cfg.Memory == nil {
+		return nil, fmt.Errorf("实验需要注入往届经验，但没有配置经验库")
+	}
+	baseSeed := cfg.Seed
+	if baseSeed == 0 {
+		baseSeed = time.Now().UnixNano()
+	}
+
+	exp := cfg.Experiment
+	arms := []struct {
+		locale  *params.Locale
+		logDir  string
+		lessons int
+	}{
+		{locale.WithVariants(exp.Control.Variants), filepath.Join(cfg.LogDir, exp.Control.Name), exp.Control.Lessons},
+		{locale.WithVariants(exp.Treatment.Variants), filepath.Join(cfg.LogDir, exp.Treatment.Name), exp.Treatment.Lessons},
+	}
+
+	// results[i][arm]
+	results := make([][2]*game.GameResult, cfg.Pairs)
+	var errs []error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	done := 0
+
+	for i := 0; i < cfg.Pairs; i++ {
+		for a := range arms {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(idx, arm int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				result, err := runGame(ctx, supervisor.GameConfig{
+					Board:  cfg.Board,
+					Locale: arms[arm].locale,
+					LogDir: arms[arm].logDir,
+					Seed:   baseSeed + int64(idx),
+					Model:  cfg.Model,
+
+					TrackBeliefs:     cfg.TrackBeliefs,
+					StructuredSpeech: cfg.StructuredSpeech,
+
+					Memory:        cfg.Memory,
+					InjectLessons: arms[arm].lessons,
+				})
+
+				mu.Lock()
+				defer mu.Unlock()
+				done++
+				if err != nil {
+					errs = append(errs, fmt.Errorf("第 %d 对第 %d 局: %w", idx+1, arm+1, err))
+					return
+				}
+				results[idx][arm] = &result
+				fmt.Printf(locale.I18n.ExperimentProgress+"\n", done, cfg.Pairs*len(arms))
+			}(i, a)
+		}
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		fmt.Printf("⚠️ %v\n", err)
+	}
+
+	// 任意一局失败则丢弃整对，保证配对比较
+	var pairs []Pair
+	for i, r := range results {
+		if r[0] == nil || r[1] == nil {
+			continue
+		}
+		pairs = append(pairs, Pair{Seed: baseSeed + int64(i), Control: *r[0], Treatment: *r[1]})
+	}
+	if len(pairs) == 0 {
+		return nil, fmt.Errorf("没有完整的成对对局")
+	}
+
+	report := NewExperimentReport(exp, pairs, locale)
+	report.Errors = cfg.Pairs - len(pairs)
+	return report, nil
+}
@@ -0,0 +1,318 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// PairedProportion 成对二值指标的比较，使用 McNemar 精确检验
+type PairedProportion struct {
+	Pairs         int     `json:"pairs"`
+	Control       float64 `json:"control"`
+	Treatment     float64 `json:"treatment"`
+	Delta         float64 `json:"delta"` // 实验组 - 对照组
+	CILow         float64 `json:"ci_low"`
+	CIHigh        float64 `json:"ci_high"`
+	ControlOnly   int     `json:"control_only"`   // 仅对照组成立的对数
+	TreatmentOnly int     `json:"treatment_only"` // 仅实验组成立的对数
+	PValue        float64 `json:"p_value"`
+}
+
+// PairedMean 成对数值指标的比较，使用配对差值的正态近似检验
+type PairedMean struct {
+	Pairs     int     `json:"pairs"`
+	Control   float64 `json:"control"`
+	Treatment float64 `json:"treatment"`
+	Delta     float64 `json:"delta"`
+	CILow     float64 `json:"ci_low"`
+	CIHigh    float64 `json:"ci_high"`
+	PValue    float64 `json:"p_value"`
+}
+
+// ExperimentReport 提示词 A/B 实验报告
+type ExperimentReport struct {
+	Experiment *params.Experiment `json:"experiment"`
+	Pairs      int                `json:"pairs"`
+	Errors     int                `json:"errors"` // 因对局失败而丢弃的对数
+
+	// 按阵营统计的胜率差异
+	WinRates map[string]PairedProportion `json:"win_rates"`
+
+	// 实验组变体作用的玩家所在阵营的胜率，仅统计目标玩家同属一个阵营的对局
+	TargetWinRate *PairedProportion `json:"target_win_rate,omitempty"`
+
+	SeerSurvival     PairedProportion `json:"seer_survival"`
+	GameLength       PairedMean       `json:"game_length"`
+	FallbacksPerGame PairedMean       `json:"fallbacks_per_game"`
+
+	Control   *Report `json:"control"`
+	Treatment *Report `json:"treatment"`
+
+	Results []Pair `json:"results"`
+
+	locale *params.Locale // 报告使用的语言
+}
+
+// NewExperimentReport 根据成对对局结果生成实验报告，locale 为报告使用的语言，为空时使用中文
+func NewExperimentReport(exp *params.Experiment, pairs []Pair, locale *params.Locale) *ExperimentReport {
+	if locale == nil {
+		locale = params.NewLocale("")
+	}
+	control := make([]game.GameResult, len(pairs))
+	treatment := make([]game.GameResult, len(pairs))
+	for i, p := range pairs {
+		control[i] = p.Control
+		treatment[i] = p.Treatment
+	}
+
+	report := &ExperimentReport{
+		locale:     locale,
+		Experiment: exp,
+		Pairs:      len(pairs),
+		WinRates:   make(map[string]PairedProportion),
+		Control:    NewReport(control, locale),
+		Treatment:  NewReport(treatment, locale),
+		Results:    pairs,
+	}
+
+	for _, faction := range []game.Faction{game.FactionVillager, game.FactionWerewolf} {
+		report.WinRates[string(faction)] = newPairedProportion(pairs, func(r game.GameResult) (bool, bool) {
+			return r.Winner == faction, true
+		})
+	}
+
+	if len(exp.Treatment.Variants) > 0 {
+		target := newPairedProportion(pairs, func(r game.GameResult) (bool, bool) {
+			faction, ok := targetFaction(exp.Treatment.Variants, r.Roles)
+			return ok && r.Winner == faction, ok
+		})
+		if target.Pairs > 0 {
+			report.TargetWinRate = &target
+		}
+	}
+
+	report.SeerSurvival = newPairedProportion(pairs, func(r game.GameResult) (bool, bool) {
+		return r.SeerSurvived, true
+	})
+	report.GameLength = newPairedMean(pairs, func(r game.GameResult) float64 {
+		return float64(r.Rounds)
+	})
+	report.FallbacksPerGame = newPairedMean(pairs, func(r game.GameResult) float64 {
+		return float64(r.Fallbacks)
+	})
+
+	return report
+}
+
+// targetFaction 变体作用的玩家所属阵营，目标玩家分属不同阵营时返回 false
+func targetFaction(variants []params.PromptVariant, roles map[string]game.Role) (game.Faction, bool) {
+	var faction game.Faction
+	for name, role := range roles {
+		for _, v := range variants {
+			if !v.Matches(name, role) {
+				continue
+			}
+			if faction != "" && faction != role.Faction() {
+				return "", false
+			}
+			faction = role.Faction()
+		}
+	}
+	return faction, faction != ""
+}
+
+// newPairedProportion 计算成对二值指标的差值、95% 置信区间和 McNemar 精确检验 p 值
+// metric 返回 (指标是否成立, 该局是否参与统计)，两局都参与统计的对才计入
+func newPairedProportion(pairs []Pair, metric func(game.GameResult) (bool, bool)) PairedProportion {
+	var n, controlHits, treatmentHits, controlOnly, treatmentOnly int
+	for _, p := range pairs {
+		c, okC := metric(p.Control)
+		t, okT := metric(p.Treatment)
+		if !okC || !okT {
+			continue
+		}
+		n++
+		if c {
+			controlHits++
+		}
+		if t {
+			treatmentHits++
+		}
+		switch {
+		case c && !t:
+			controlOnly++
+		case t && !c:
+			treatmentOnly++
+		}
+	}
+	if n == 0 {
+		return PairedProportion{PValue: 1}
+	}
+
+	nf := float64(n)
+	delta := float64(treatmentOnly-controlOnly) / nf
+	discordant := float64(controlOnly + treatmentOnly)
+	stderr := math.Sqrt(math.Max(0, discordant-float64((treatmentOnly-controlOnly)*(treatmentOnly-controlOnly))/nf)) / nf
+
+	return PairedProportion{
+		Pairs:         n,
+		Control:       float64(controlHits) / nf,
+		Treatment:     float64(treatmentHits) / nf,
+		Delta:         delta,
+		CILow:         math.Max(-1, delta-z95*stderr),
+		CIHigh:        math.Min(1, delta+z95*stderr),
+		ControlOnly:   controlOnly,
+		TreatmentOnly: treatmentOnly,
+		PValue:        mcNemarExact(controlOnly, treatmentOnly),
+	}
+}
+
+// mcNemarExact McNemar 精确检验（不一致对上的双侧二项检验）
+func mcNemarExact(b, c int) float64 {
+	n := b + c
+	if n == 0 {
+		return 1
+	}
+	k := min(b, c)
+
+	// P(X <= k)，X ~ Binomial(n, 0.5)，在对数空间累加避免溢出
+	var cdf float64
+	for i := 0; i <= k; i++ {
+		lg := lgammaInt(n+1) - lgammaInt(i+1) - lgammaInt(n-i+1) - float64(n)*math.Ln2
+		cdf += math.Exp(lg)
+	}
+	return math.Min(1, 2*cdf)
+}
+
+func lgammaInt(n int) float64 {
+	v, _ := math.Lgamma(float64(n))
+	return v
+}
+
+// newPairedMean 计算成对数值指标的差值、95% 置信区间和双侧 p 值（正态近似）
+func newPairedMean(pairs []Pair, metric func(game.GameResult) float64) PairedMean {
+	n := len(pairs)
+	if n == 0 {
+		return PairedMean{PValue: 1}
+	}
+
+	var controls, treatments, diffs []float64
+	for _, p := range pairs {
+		c, t := metric(p.Control), metric(p.Treatment)
+		controls = append(controls, c)
+		treatments = append(treatments, t)
+		diffs = append(diffs, t-c)
+	}
+
+	diff := newMean(diffs)
+	result := PairedMean{
+		Pairs:     n,
+		Control:   newMean(controls).Value,
+		Treatment: newMean(treatments).Value,
+		Delta:     diff.Value,
+		CILow:     diff.CILow,
+		CIHigh:    diff.CIHigh,
+		PValue:    1,
+	}
+
+	stderr := (diff.CIHigh - diff.Value) / z95
+	switch {
+	case stderr > 0:
+		result.PValue = math.Erfc(math.Abs(diff.Value/stderr) / math.Sqrt2)
+	case n > 1 && diff.Value != 0:
+		// 所有对的差值完全一致且不为 0
+		result.PValue = 0
+	}
+	return result
+}
+
+// Markdown 生成 Markdown 格式的实验报告
+func (r *ExperimentReport) Markdown() string {
+	text, log := &r.locale.I18n.Report, &r.locale.I18n.Log
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(text.ExperimentTitle+"\n\n", r.Experiment.Name))
+	if r.Experiment.Description != "" {
+		sb.WriteString(r.Experiment.Description + "\n\n")
+	}
+	sb.WriteString(fmt.Sprintf(text.ExperimentPairs+"\n\n",
+		r.Pairs, r.Errors, r.Experiment.Control.Name, r.Experiment.Treatment.Name))
+
+	sb.WriteString(text.ExperimentMetrics + "\n\n")
+	sb.WriteString(text.TableHeader(text.ExperimentHeader))
+	for _, faction := range []game.Faction{game.FactionVillager, game.FactionWerewolf} {
+		name := fmt.Sprintf(text.ExperimentWinRate, log.CheckResultName(game.CheckResult{Faction: faction}))
+		sb.WriteString(pairedProportionRow(name, r.WinRates[string(faction)]))
+	}
+	if r.TargetWinRate != nil {
+		sb.WriteString(pairedProportionRow(text.ExperimentTargetWin, *r.TargetWinRate))
+	}
+	sb.WriteString(pairedProportionRow(text.ExperimentSeer, r.SeerSurvival))
+	sb.WriteString(pairedMeanRow(text.ExperimentLength, r.GameLength))
+	sb.WriteString(pairedMeanRow(text.ExperimentFallbacks, r.FallbacksPerGame))
+
+	sb.WriteString("\n" + text.ExperimentNote + "\n")
+
+	return sb.String()
+}
+
+// Save 保存实验报告，并在子目录中保存两组各自的模拟报告
+func (r *ExperimentReport) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("创建报告目录失败: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "experiment.md"), []byte(r.Markdown()), 0644); err != nil {
+		return fmt.Errorf("保存 Markdown 报告失败: %w", err)
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化报告失败: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "experiment.json"), data, 0644); err != nil {
+		return fmt.Errorf("保存 JSON 报告失败: %w", err)
+	}
+
+	if err := r.Control.Save(filepath.Join(dir, r.Experiment.Control.Name)); err != nil {
+		return err
+	}
+	if err := r.Treatment.Save(filepath.Join(dir, r.Experiment.Treatment.Name)); err != nil {
+		return err
+	}
+
+	fmt.Printf("实验报告已保存到: %s\n", dir)
+	return nil
+}
+
+func pairedProportionRow(name string, p PairedProportion) string {
+	return fmt.Sprintf("| %s | %d | %.1f%% | %.1f%% | %+.1f%% | [%+.1f%%, %+.1f%%] | %.3f |\n",
+		name, p.Pairs, p.Control*100, p.Treatment*100, p.Delta*100, p.CILow*100, p.CIHigh*100, p.PValue)
+}
+
+func pairedMeanRow(name string, m PairedMean) string {
+	return fmt.Sprintf("| %s | %d | %.2f | %.2f | %+.2f | [%+.2f, %+.2f] | %.3f |\n",
+		name, m.Pairs, m.Control, m.Treatment, m.Delta, m.CILow, m.CIHigh, m.PValue)
+}
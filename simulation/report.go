@@ -0,0 +1,325 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// z95 95% 置信区间对应的 z 值
+const z95 = 1.96
+
+// Proportion 比例及其 95% Wilson 置信区间
+type Proportion struct {
+	Count  int     `json:"count"`
+	Rate   float64 `json:"rate"`
+	CILow  float64 `json:"ci_low"`
+	CIHigh float64 `json:"ci_high"`
+}
+
+// Mean 均值及其 95% 置信区间（正态近似）
+type Mean struct {
+	Value  float64 `json:"value"`
+	CILow  float64 `json:"ci_low"`
+	CIHigh float64 `json:"ci_high"`
+}
+
+// Report 批量模拟统计报告
+type Report struct {
+	Games  int `json:"games"`
+	Errors int `json:"errors"`
+
+	// 按阵营统计的胜率，"none" 表示超过最大回合数
+	WinRates map[string]Proportion `json:"win_rates"`
+
+	GameLength Mean `json:"game_length"`
+
+	// 首夜被刀角色分布，"none" 表示首夜未击杀
+	FirstNightKills map[string]Proportion `json:"first_night_kills"`
+
+	SeerSurvival Proportion `json:"seer_survival"`
+
+	// 药水使用率及平均使用回合
+	HealingUsed  Proportion `json:"healing_used"`
+	HealingRound Mean       `json:"healing_round"`
+	PoisonUsed   Proportion `json:"poison_used"`
+	PoisonRound  Mean       `json:"poison_round"`
+
+	// 每局回退到文本解析的次数
+	Fallbacks        int  `json:"fallbacks"`
+	FallbacksPerGame Mean `json:"fallbacks_per_game"`
+
+	// 按模型汇总的好人阵营概率判断评分（开启 belief 追踪时）
+	Calibration map[string]*game.Calibration `json:"calibration,omitempty"`
+
+	Results []game.GameResult `json:"results"`
+
+	locale *params.Locale // 报告使用的语言
+}
+
+// NewReport 根据对局结果生成统计报告，locale 为报告使用的语言，为空时使用中文
+func NewReport(results []game.GameResult, locale *params.Locale) *Report {
+	if locale == nil {
+		locale = params.NewLocale("")
+	}
+	n := len(results)
+	report := &Report{
+		locale:          locale,
+		Games:           n,
+		WinRates:        make(map[string]Proportion),
+		FirstNightKills: make(map[string]Proportion),
+		Results:         results,
+	}
+
+	winCounts := map[string]int{
+		string(game.FactionWerewolf): 0,
+		string(game.FactionVillager): 0,
+		"none":                       0,
+	}
+	killCounts := make(map[string]int)
+	var lengths, healingRounds, poisonRounds, fallbacks []float64
+	seerAlive := 0
+
+	for _, r := range results {
+		winner := string(r.Winner)
+		if winner == "" {
+			winner = "none"
+		}
+		winCounts[winner]++
+
+		kill := string(r.FirstNightKill)
+		if kill == "" {
+			kill = "none"
+		}
+		killCounts[kill]++
+
+		if r.SeerSurvived {
+			seerAlive++
+		}
+		if r.HealingRound > 0 {
+			healingRounds = append(healingRounds, float64(r.HealingRound))
+		}
+		if r.PoisonRound > 0 {
+			poisonRounds = append(poisonRounds, float64(r.PoisonRound))
+		}
+
+		if r.Belief != nil {
+			model := r.Model
+			if model == "" {
+				model = "unknown"
+			}
+			if report.Calibration == nil {
+				report.Calibration = make(map[string]*game.Calibration)
+			}
+			if report.Calibration[model] == nil {
+				report.Calibration[model] = game.NewCalibration()
+			}
+			report.Calibration[model].Merge(r.Belief)
+		}
+
+		lengths = append(lengths, float64(r.Rounds))
+		fallbacks = append(fallbacks, float64(r.Fallbacks))
+		report.Fallbacks += r.Fallbacks
+	}
+
+	for k, c := range winCounts {
+		report.WinRates[k] = newProportion(c, n)
+	}
+	for k, c := range killCounts {
+		report.FirstNightKills[k] = newProportion(c, n)
+	}
+	report.GameLength = newMean(lengths)
+	report.SeerSurvival = newProportion(seerAlive, n)
+	report.HealingUsed = newProportion(len(healingRounds), n)
+	report.HealingRound = newMean(healingRounds)
+	report.PoisonUsed = newProportion(len(poisonRounds), n)
+	report.PoisonRound = newMean(poisonRounds)
+	report.FallbacksPerGame = newMean(fallbacks)
+
+	return report
+}
+
+// newProportion 计算比例的 Wilson 置信区间
+func newProportion(count, n int) Proportion {
+	if n == 0 {
+		return Proportion{}
+	}
+	p := float64(count) / float64(n)
+	nf := float64(n)
+	denom := 1 + z95*z95/nf
+	center := (p + z95*z95/(2*nf)) / denom
+	margin := z95 * math.Sqrt(p*(1-p)/nf+z95*z95/(4*nf*nf)) / denom
+	return Proportion{
+		Count:  count,
+		Rate:   p,
+		CILow:  math.Max(0, center-margin),
+		CIHigh: math.Min(1, center+margin),
+	}
+}
+
+// newMean 计算均值的正态近似置信区间
+func newMean(values []float64) Mean {
+	n := len(values)
+	if n == 0 {
+		return Mean{}
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(n)
+	if n == 1 {
+		return Mean{Value: mean, CILow: mean, CIHigh: mean}
+	}
+
+	var sq float64
+	for _, v := range values {
+		sq += (v - mean) * (v - mean)
+	}
+	stderr := math.Sqrt(sq/float64(n-1)) / math.Sqrt(float64(n))
+	return Mean{Value: mean, CILow: mean - z95*stderr, CIHigh: mean + z95*stderr}
+}
+
+// Markdown 生成 Markdown 格式的报告
+func (r *Report) Markdown() string {
+	text, log := &r.locale.I18n.Report, &r.locale.I18n.Log
+	var sb strings.Builder
+	sb.WriteString(text.SimulationTitle + "\n\n")
+	sb.WriteString(fmt.Sprintf(text.SimulationGames+"\n\n", r.Games, r.Errors))
+
+	sb.WriteString(text.WinRates + "\n\n")
+	sb.WriteString(text.TableHeader(text.WinRateTableHeader))
+	for _, k := range sortedKeys(r.WinRates) {
+		name := log.CheckResultName(game.CheckResult{Faction: game.Faction(k)})
+		sb.WriteString(proportionRow(noneName(k, name, text), r.WinRates[k]))
+	}
+
+	sb.WriteString("\n" + text.GameLength + "\n\n")
+	sb.WriteString(fmt.Sprintf(text.MeanRounds+"\n\n", formatMean(r.GameLength)))
+
+	sb.WriteString(text.FirstNightKills + "\n\n")
+	sb.WriteString(text.TableHeader(text.FirstKillHeader))
+	for _, k := range sortedKeys(r.FirstNightKills) {
+		name := log.CheckResultName(game.CheckResult{Role: game.Role(k)})
+		sb.WriteString(proportionRow(noneName(k, name, text), r.FirstNightKills[k]))
+	}
+
+	sb.WriteString("\n" + text.SeerSurvival + "\n\n")
+	sb.WriteString(fmt.Sprintf(text.SurvivalRate+"\n\n", formatProportion(r.SeerSurvival)))
+
+	sb.WriteString(text.Potions + "\n\n")
+	sb.WriteString(text.TableHeader(text.PotionTableHeader))
+	sb.WriteString(fmt.Sprintf("| %s | %s | %s |\n", text.HealingPotion, formatProportion(r.HealingUsed), formatMean(r.HealingRound)))
+	sb.WriteString(fmt.Sprintf("| %s | %s | %s |\n", text.PoisonPotion, formatProportion(r.PoisonUsed), formatMean(r.PoisonRound)))
+
+	sb.WriteString("\n" + text.Fallbacks + "\n\n")
+	sb.WriteString(fmt.Sprintf(text.FallbackSummary+"\n", r.Fallbacks, formatMean(r.FallbacksPerGame)))
+
+	if len(r.Calibration) > 0 {
+		sb.WriteString("\n" + text.Calibration + "\n\n")
+		sb.WriteString(text.CalibrationNote + "\n\n")
+		sb.WriteString(text.TableHeader(text.CalibrationHeader))
+		models := make([]string, 0, len(r.Calibration))
+		for model := range r.Calibration {
+			models = append(models, model)
+		}
+		sort.Strings(models)
+		for _, model := range models {
+			c := r.Calibration[model]
+			sb.WriteString(fmt.Sprintf("| %s | %d | %.3f | %.3f |\n", model, c.Samples, c.Brier, c.LogLoss))
+		}
+		for _, model := range models {
+			sb.WriteString(fmt.Sprintf("\n"+text.CalibrationCurve+"\n\n", model))
+			sb.WriteString(CalibrationTable(r.Calibration[model], text))
+		}
+	}
+
+	return sb.String()
+}
+
+// Save 将报告以 Markdown 和 JSON 格式保存到指定目录
+func (r *Report) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("创建报告目录失败: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "report.md"), []byte(r.Markdown()), 0644); err != nil {
+		return fmt.Errorf("保存 Markdown 报告失败: %w", err)
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化报告失败: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "report.json"), data, 0644); err != nil {
+		return fmt.Errorf("保存 JSON 报告失败: %w", err)
+	}
+
+	fmt.Printf("报告已保存到: %s\n", dir)
+	return nil
+}
+
+// CalibrationTable 生成校准曲线表格，只列出有样本的概率区间
+func CalibrationTable(c *game.Calibration, text *params.ReportText) string {
+	var sb strings.Builder
+	sb.WriteString(text.TableHeader(text.CalibrationBins))
+	for _, bin := range c.Bins {
+		if bin.Count == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("| [%.1f, %.1f) | %d | %.2f | %.2f |\n", bin.Low, bin.High, bin.Count, bin.MeanPredicted, bin.Observed))
+	}
+	return sb.String()
+}
+
+// noneName 统计键为 "none"（没有胜者、首夜未击杀）时返回本地化的空值，否则返回 name
+func noneName(key, name string, text *params.ReportText) string {
+	if key == "none" {
+		return text.None
+	}
+	return name
+}
+
+func proportionRow(name string, p Proportion) string {
+	return fmt.Sprintf("| %s | %d | %.1f%% | [%.1f%%, %.1f%%] |\n", name, p.Count, p.Rate*100, p.CILow*100, p.CIHigh*100)
+}
+
+func formatProportion(p Proportion) string {
+	return fmt.Sprintf("%.1f%% [%.1f%%, %.1f%%]", p.Rate*100, p.CILow*100, p.CIHigh*100)
+}
+
+func formatMean(m Mean) string {
+	return fmt.Sprintf("%.2f [%.2f, %.2f]", m.Value, m.CILow, m.CIHigh)
+}
+
+func sortedKeys(m map[string]Proportion) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// sampleResults 三局结果：好人胜、狼人胜、超过最大回合数
+func sampleResults() []game.GameResult {
+	roles := map[string]game.Role{"Player1": game.RoleWerewolf, "Player2": game.RoleSeer, "Player3": game.RoleVillager}
+	belief, _ := game.ScoreBeliefs([]game.Belief{{Player: "Player3", Probs: map[string]float64{"Player1": 0.8, "Player2": 0.3}}}, roles)
+	return []game.GameResult{
+		{Winner: game.FactionVillager, Rounds: 3, Roles: roles, FirstNightKill: game.RoleSeer, HealingRound: 1, Model: "test", Belief: belief},
+		{Winner: game.FactionWerewolf, Rounds: 4, Roles: roles, PoisonRound: 2, SeerSurvived: true},
+		{Rounds: 10, Roles: roles, Fallbacks: 2},
+	}
+}
+
+// hasChinese 判断文本中是否有汉字
+func hasChinese(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool { return unicode.Is(unicode.Han, r) }) >= 0
+}
+
+func TestReportMarkdownUsesLocale(t *testing.T) {
+	for _, lang := range []string{"zh", "en", "ja"} {
+		t.Run(lang, func(t *testing.T) {
+			locale := params.NewLocale(lang)
+			text, log := &locale.I18n.Report, &locale.I18n.Log
+			md := NewReport(sampleResults(), locale).Markdown()
+
+			for _, want := range []string{
+				text.SimulationTitle,
+				text.WinRates,
+				text.FirstNightKills,
+				text.HealingPotion,
+				text.Calibration,
+				"| " + log.FactionNames[game.FactionWerewolf] + " |",
+				"| " + log.RoleNames[game.RoleSeer] + " |",
+				"| " + text.None + " |",
+			} {
+				if !strings.Contains(md, want) {
+					t.Errorf("报告中缺少 %q", want)
+				}
+			}
+			if lang == "en" && hasChinese(md) {
+				t.Errorf("英文报告中有中文:\n%s", md)
+			}
+		})
+	}
+}
+
+func TestExperimentReportMarkdownUsesLocale(t *testing.T) {
+	exp := &params.Experiment{
+		Name:      "seer-claim",
+		Control:   params.ExperimentArm{Name: "baseline"},
+		Treatment: params.ExperimentArm{Name: "early-claim", Variants: []params.PromptVariant{{Role: game.RoleSeer, Append: "..."}}},
+	}
+	results := sampleResults()
+	pairs := []Pair{{Seed: 1, Control: results[0], Treatment: results[1]}, {Seed: 2, Control: results[1], Treatment: results[0]}}
+
+	for _, lang := range []string{"zh", "en", "ja"} {
+		t.Run(lang, func(t *testing.T) {
+			locale := params.NewLocale(lang)
+			text, log := &locale.I18n.Report, &locale.I18n.Log
+			md := NewExperimentReport(exp, pairs, locale).Markdown()
+
+			for _, want := range []string{
+				"seer-claim",
+				text.ExperimentMetrics,
+				text.ExperimentSeer,
+				text.ExperimentTargetWin,
+				text.ExperimentNote,
+				"| " + fmt.Sprintf(text.ExperimentWinRate, log.FactionNames[game.FactionVillager]) + " |",
+			} {
+				if !strings.Contains(md, want) {
+					t.Errorf("报告中缺少 %q", want)
+				}
+			}
+			if lang == "en" && hasChinese(md) {
+				t.Errorf("英文报告中有中文:\n%s", md)
+			}
+		})
+	}
+}
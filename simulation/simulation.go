@@ -0,0 +1,155 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/adk"
+
+	"github.com/ashwinyue/wolf-go-adk/agents/supervisor"
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/memory"
+	"github.com/ashwinyue/wolf-go-adk/params"
+	"github.com/ashwinyue/wolf-go-adk/utils"
+)
+
+// Config 批量模拟配置
+type Config struct {
+	Games       int                // 模拟局数
+	Concurrency int                // 同时进行的最大局数
+	Board       params.BoardConfig // 板子配置
+	Locale      *params.Locale     // 语言包，为空时使用中文
+	LogDir      string             // 每局日志的根目录
+	Seed        int64              // 第一局的种子，后续依次加一；为 0 时每局使用当前时间
+	Model       utils.ModelConfig  // 玩家使用的模型
+	Verbosity   int                // 输出级别：0 不输出，1 输出每局进度，2 同时输出每局的胜利方和回合数
+
+	TrackBeliefs     bool // 是否记录并评估玩家的概率判断
+	StructuredSpeech bool // 是否为玩家附加 speech 工具
+
+	Memory        *memory.Store // 跨局经验库，为空时不读写
+	RecordLessons bool          // 是否把每局的赛后经验写入经验库
+	InjectLessons int           // 每个角色注入的往届经验条数
+}
+
+// Run 运行多局游戏并汇总统计结果
+// 每局使用独立的 ModeratorAgent（以及其中的 GameState 和 GameLogger）
+func Run(ctx context.Context, cfg Config) (*Report, error) {
+	if cfg.Games <= 0 {
+		return nil, fmt.Errorf("模拟局数必须大于 0")
+	}
+	concurrency := cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	locale := cfg.Locale
+	if locale == nil {
+		locale = params.NewLocale("")
+	}
+
+	results := make([]game.GameResult, 0, cfg.Games)
+	var errs []error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for i := 0; i < cfg.Games; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(idx int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var seed int64
+			if cfg.Seed != 0 {
+				seed = cfg.Seed + int64(idx)
+			}
+			result, err := runGame(ctx, supervisor.GameConfig{
+				Board:  cfg.Board,
+				Locale: cfg.Locale,
+				LogDir: cfg.LogDir,
+				Seed:   seed,
+				Model:  cfg.Model,
+
+				TrackBeliefs:     cfg.TrackBeliefs,
+				StructuredSpeech: cfg.StructuredSpeech,
+
+				Memory:        cfg.Memory,
+				RecordLessons: cfg.RecordLessons,
+				InjectLessons: cfg.InjectLessons,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("第 %d 局: %w", idx+1, err))
+				return
+			}
+			results = append(results, result)
+			if cfg.Verbosity >= 2 {
+				winner := string(result.Winner)
+				if winner == "" {
+					winner = "-"
+				}
+				fmt.Printf(strings.TrimPrefix(locale.I18n.GameSummary, "\n")+"\n", result.GameID, winner, result.Rounds)
+			}
+			if cfg.Verbosity >= 1 {
+				fmt.Printf(locale.I18n.SimulationProgress+"\n", len(results)+len(errs), cfg.Games)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		fmt.Printf("⚠️ %v\n", err)
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("所有模拟对局均失败")
+	}
+
+	report := NewReport(results, locale)
+	report.Errors = len(errs)
+	return report, nil
+}
+
+// runGame 运行一局游戏，不打印事件，只返回结果
+func runGame(ctx context.Context, cfg supervisor.GameConfig) (game.GameResult, error) {
+	moderator, err := supervisor.NewModeratorAgent(ctx, cfg)
+	if err != nil {
+		return game.GameResult{}, err
+	}
+
+	runner := adk.NewRunner(ctx, adk.RunnerConfig{
+		Agent: moderator,
+	})
+	iter := runner.Query(ctx, "开始一局狼人杀游戏")
+	for {
+		event, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if event.Err != nil {
+			return game.GameResult{}, event.Err
+		}
+	}
+
+	return moderator.Result(), nil
+}
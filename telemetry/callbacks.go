@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+	ucb "github.com/cloudwego/eino/utils/callbacks"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type startTimeKey struct{}
+
+// NewCallbackHandler 创建 Eino 回调处理器：每次模型调用和工具调用生成一个 span（挂在当前玩家调用的 span 下），
+// 并统计模型耗时、token 用量、工具调用次数和错误
+func NewCallbackHandler() callbacks.Handler {
+	return ucb.NewHandlerHelper().
+		ChatModel(&ucb.ModelCallbackHandler{
+			OnStart: func(ctx context.Context, info *callbacks.RunInfo, input *model.CallbackInput) context.Context {
+				ctx, _ = StartSpan(ctx, "model."+info.Name, AttrModel.String(modelName(input.Config)))
+				return context.WithValue(ctx, startTimeKey{}, time.Now())
+			},
+			OnEnd: func(ctx context.Context, info *callbacks.RunInfo, output *model.CallbackOutput) context.Context {
+				endModelSpan(ctx, output.Config, output.TokenUsage, nil)
+				return ctx
+			},
+			OnEndWithStreamOutput: func(ctx context.Context, info *callbacks.RunInfo, output *schema.StreamReader[*model.CallbackOutput]) context.Context {
+				go func() {
+					defer output.Close()
+					var cfg *model.Config
+					var usage *model.TokenUsage
+					var streamErr error
+					for {
+						chunk, err := output.Recv()
+						if errors.Is(err, io.EOF) {
+							break
+						}
+						if err != nil {
+							streamErr = err
+							break
+						}
+						if chunk.Config != nil {
+							cfg = chunk.Config
+						}
+						if chunk.TokenUsage != nil {
+							usage = chunk.TokenUsage
+						}
+					}
+					endModelSpan(ctx, cfg, usage, streamErr)
+				}()
+				return ctx
+			},
+			OnError: func(ctx context.Context, info *callbacks.RunInfo, err error) context.Context {
+				endModelSpan(ctx, nil, nil, err)
+				return ctx
+			},
+		}).
+		Tool(&ucb.ToolCallbackHandler{
+			OnStart: func(ctx context.Context, info *callbacks.RunInfo, input *tool.CallbackInput) context.Context {
+				toolCallsTotal.WithLabelValues(info.Name).Inc()
+				ctx, _ = StartSpan(ctx, "tool."+info.Name, AttrTool.String(info.Name))
+				return ctx
+			},
+			OnEnd: func(ctx context.Context, info *callbacks.RunInfo, output *tool.CallbackOutput) context.Context {
+				EndSpan(trace.SpanFromContext(ctx), nil)
+				return ctx
+			},
+			OnError: func(ctx context.Context, info *callbacks.RunInfo, err error) context.Context {
+				RecordError("tool")
+				EndSpan(trace.SpanFromContext(ctx), err)
+				return ctx
+			},
+		}).
+		Handler()
+}
+
+// endModelSpan 结束模型调用的 span 并记录耗时和 token 用量
+func endModelSpan(ctx context.Context, cfg *model.Config, usage *model.TokenUsage, err error) {
+	span := trace.SpanFromContext(ctx)
+	name := modelName(cfg)
+	if start, ok := ctx.Value(startTimeKey{}).(time.Time); ok {
+		modelCallSeconds.WithLabelValues(name).Observe(time.Since(start).Seconds())
+	}
+	if usage != nil {
+		span.SetAttributes(AttrInTokens.Int(usage.PromptTokens), AttrOutTokens.Int(usage.CompletionTokens))
+		tokensTotal.WithLabelValues(name, "prompt").Add(float64(usage.PromptTokens))
+		tokensTotal.WithLabelValues(name, "completion").Add(float64(usage.CompletionTokens))
+	}
+	if err != nil {
+		RecordError("model")
+	}
+	EndSpan(span, err)
+}
+
+func modelName(cfg *model.Config) string {
+	if cfg == nil || cfg.Model == "" {
+		return "unknown"
+	}
+	return cfg.Model
+}
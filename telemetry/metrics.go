@@ -0,0 +1,174 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry 本项目指标使用的 Prometheus 注册表
+var Registry = prometheus.NewRegistry()
+
+var (
+	gamesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "werewolf_games_total",
+		Help: "已结束的对局数，按胜利阵营区分（none 表示达到回合上限）",
+	}, []string{"winner"})
+
+	fallbacksTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "werewolf_fallbacks_total",
+		Help: "玩家回复无法解析为工具输出、回退到文本解析的次数",
+	})
+
+	errorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "werewolf_errors_total",
+		Help: "错误次数，按来源区分（player / model / tool）",
+	}, []string{"source"})
+
+	playerCallSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "werewolf_player_call_duration_seconds",
+		Help:    "一次玩家调用（包含其 ReAct 循环中的全部模型和工具调用）的耗时",
+		Buckets: prometheus.ExponentialBuckets(0.5, 2, 10),
+	}, []string{"role"})
+
+	modelCallSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "werewolf_model_call_duration_seconds",
+		Help:    "单次模型调用的耗时",
+		Buckets: prometheus.ExponentialBuckets(0.25, 2, 10),
+	}, []string{"model"})
+
+	toolCallsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "werewolf_tool_calls_total",
+		Help: "工具调用次数",
+	}, []string{"tool"})
+
+	queueWaitSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "werewolf_llm_queue_wait_seconds",
+		Help:    "模型调用等待并发名额和限流额度的时间",
+		Buckets: prometheus.ExponentialBuckets(0.01, 2, 14),
+	}, []string{"provider"})
+
+	inflightCalls = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "werewolf_llm_inflight_calls",
+		Help: "正在进行的模型调用数",
+	})
+
+	cacheTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "werewolf_llm_cache_total",
+		Help: "模型响应缓存的查询次数，按命中（hit）和未命中（miss）区分",
+	}, []string{"result"})
+
+	cacheErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "werewolf_llm_cache_errors_total",
+		Help: "模型响应写入缓存失败的次数",
+	})
+
+	tokensTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "werewolf_tokens_total",
+		Help: "模型消耗的 token 数，按输入（prompt）和输出（completion）区分",
+	}, []string{"model", "type"})
+)
+
+func init() {
+	Registry.MustRegister(collectors()...)
+}
+
+// collectors 返回本项目的全部指标，测试时可以注册到独立的注册表
+func collectors() []prometheus.Collector {
+	return []prometheus.Collector{gamesTotal, fallbacksTotal, errorsTotal, playerCallSeconds, modelCallSeconds, toolCallsTotal, tokensTotal, queueWaitSeconds, inflightCalls, cacheTotal, cacheErrorsTotal}
+}
+
+// RecordGame 记录一局结束
+func RecordGame(winner string) {
+	if winner == "" {
+		winner = "none"
+	}
+	gamesTotal.WithLabelValues(winner).Inc()
+}
+
+// RecordFallback 记录一次文本解析回退
+func RecordFallback() {
+	fallbacksTotal.Inc()
+}
+
+// RecordError 记录一次错误
+func RecordError(source string) {
+	errorsTotal.WithLabelValues(source).Inc()
+}
+
+// ObservePlayerCall 记录一次玩家调用的耗时
+func ObservePlayerCall(role string, d time.Duration) {
+	playerCallSeconds.WithLabelValues(role).Observe(d.Seconds())
+}
+
+// ObserveQueueWait 记录一次模型调用的排队时间
+func ObserveQueueWait(provider string, d time.Duration) {
+	queueWaitSeconds.WithLabelValues(provider).Observe(d.Seconds())
+}
+
+// AddInflight 调整正在进行的模型调用数
+func AddInflight(delta float64) {
+	inflightCalls.Add(delta)
+}
+
+// RecordCache 记录一次响应缓存查询
+func RecordCache(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	cacheTotal.WithLabelValues(result).Inc()
+}
+
+// RecordCacheError 记录一次响应缓存写入失败
+func RecordCacheError() {
+	cacheErrorsTotal.Inc()
+}
+
+// Handler 返回 /metrics 处理器
+func Handler() http.Handler {
+	return handlerFor(Registry)
+}
+
+// handlerFor 返回指定注册表的 /metrics 处理器
+func handlerFor(reg *prometheus.Registry) http.Handler {
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg})
+}
+
+// ServeMetrics 在后台启动 /metrics 服务
+func ServeMetrics(addr string) (*http.Server, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("监听指标地址 %s 失败: %w", addr, err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Printf("⚠️ 指标服务退出: %v\n", err)
+		}
+	}()
+	return server, nil
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/callbacks"
+	"go.opentelemetry.io/otel"
+)
+
+// Config 可观测性配置
+type Config struct {
+	// Exporter 链路导出方式：otlp（地址等参数读取标准的 OTEL_EXPORTER_OTLP_* 环境变量）、stdout，为空时不导出
+	Exporter string
+	// MetricsAddr Prometheus /metrics 监听地址（如 :9464），为空时不启动
+	MetricsAddr string
+}
+
+// ConfigFromEnv 从环境变量读取配置：OTEL_TRACES_EXPORTER 和 METRICS_ADDR
+func ConfigFromEnv() Config {
+	return Config{
+		Exporter:    strings.ToLower(os.Getenv("OTEL_TRACES_EXPORTER")),
+		MetricsAddr: os.Getenv("METRICS_ADDR"),
+	}
+}
+
+var registerOnce sync.Once
+
+// Setup 按配置初始化链路追踪和指标，返回退出前需要调用的关闭函数
+// 开启任意一项时都会注册 Eino 全局回调，为模型和工具调用生成 span 并统计指标
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	var shutdowns []func(context.Context) error
+
+	if cfg.Exporter != "" && cfg.Exporter != "none" {
+		exporter, err := newExporter(ctx, cfg.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		tp := NewTracerProvider(exporter)
+		otel.SetTracerProvider(tp)
+		shutdowns = append(shutdowns, tp.Shutdown)
+	}
+
+	if cfg.MetricsAddr != "" {
+		server, err := ServeMetrics(cfg.MetricsAddr)
+		if err != nil {
+			return nil, err
+		}
+		shutdowns = append(shutdowns, server.Shutdown)
+	}
+
+	if len(shutdowns) > 0 {
+		registerOnce.Do(func() {
+			callbacks.AppendGlobalHandlers(NewCallbackHandler())
+		})
+	}
+
+	return func(ctx context.Context) error {
+		var errs []error
+		for _, fn := range shutdowns {
+			if err := fn(ctx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if err := errors.Join(errs...); err != nil {
+			return fmt.Errorf("关闭可观测性组件失败: %w", err)
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,220 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// setupTracing 把全局 TracerProvider 换成内存导出器，测试结束后恢复
+func setupTracing(t *testing.T) func() tracetest.SpanStubs {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	tp := NewTracerProvider(exporter)
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		_ = tp.Shutdown(context.Background())
+	})
+	return func() tracetest.SpanStubs {
+		if err := tp.ForceFlush(context.Background()); err != nil {
+			t.Fatalf("ForceFlush: %v", err)
+		}
+		return exporter.GetSpans()
+	}
+}
+
+// spanByName 按名称查找 span
+func spanByName(t *testing.T, spans tracetest.SpanStubs, name string) tracetest.SpanStub {
+	t.Helper()
+	for _, s := range spans {
+		if s.Name == name {
+			return s
+		}
+	}
+	t.Fatalf("没有找到 span %q", name)
+	return tracetest.SpanStub{}
+}
+
+// attr 读取 span 的属性
+func attr(s tracetest.SpanStub, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range s.Attributes {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+// scrape 从独立注册表的 /metrics 读取文本格式的指标
+func scrape(t *testing.T, reg *prometheus.Registry) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handlerFor(reg).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := io.ReadAll(rec.Body)
+	if err != nil {
+		t.Fatalf("读取 /metrics 失败: %v", err)
+	}
+	return string(body)
+}
+
+func TestCallbackSpansNestUnderPlayerCall(t *testing.T) {
+	flush := setupTracing(t)
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(collectors()...)
+	handler := NewCallbackHandler()
+
+	ctx := context.Background()
+	ctx, game := StartSpan(ctx, "game", AttrGameID.String("g1"), AttrSeed.Int64(42))
+	ctx, round := StartSpan(ctx, "round", AttrRound.Int(1))
+	ctx, phase := StartSpan(ctx, "phase.night", AttrPhase.String("night"))
+	callCtx, call := StartSpan(ctx, "player.call",
+		AttrSeat.String("Player3"), AttrRole.String("seer"), AttrRound.Int(1), AttrPhase.String("night"))
+
+	modelInfo := &callbacks.RunInfo{Name: "chat", Component: components.ComponentOfChatModel}
+	modelCtx := handler.OnStart(callCtx, modelInfo, &model.CallbackInput{Config: &model.Config{Model: "test-model"}})
+	time.Sleep(time.Millisecond)
+	handler.OnEnd(modelCtx, modelInfo, &model.CallbackOutput{
+		Config:     &model.Config{Model: "test-model"},
+		TokenUsage: &model.TokenUsage{PromptTokens: 120, CompletionTokens: 30},
+	})
+
+	toolInfo := &callbacks.RunInfo{Name: "check_identity", Component: components.ComponentOfTool}
+	toolCtx := handler.OnStart(callCtx, toolInfo, &tool.CallbackInput{ArgumentsInJSON: `{"target":"Player5"}`})
+	handler.OnEnd(toolCtx, toolInfo, &tool.CallbackOutput{Response: "ok"})
+
+	failedCtx := handler.OnStart(callCtx, toolInfo, &tool.CallbackInput{})
+	handler.OnError(failedCtx, toolInfo, errors.New("boom"))
+
+	EndSpan(call, nil)
+	phase.End()
+	round.End()
+	game.End()
+
+	spans := flush()
+	if len(spans) != 7 {
+		t.Fatalf("导出了 %d 个 span，期望 7 个", len(spans))
+	}
+
+	// game → round → phase → player.call → model / tool
+	chain := []string{"game", "round", "phase.night", "player.call", "model.chat"}
+	for i := 1; i < len(chain); i++ {
+		parent, child := spanByName(t, spans, chain[i-1]), spanByName(t, spans, chain[i])
+		if child.Parent.SpanID() != parent.SpanContext.SpanID() {
+			t.Errorf("%s 的父 span 不是 %s", chain[i], chain[i-1])
+		}
+	}
+	playerCall := spanByName(t, spans, "player.call")
+	for _, s := range spans {
+		if s.Name == "tool.check_identity" && s.Parent.SpanID() != playerCall.SpanContext.SpanID() {
+			t.Errorf("工具 span 没有挂在 player.call 下")
+		}
+	}
+
+	if v, _ := attr(playerCall, AttrSeat); v.AsString() != "Player3" {
+		t.Errorf("seat = %q，期望 Player3", v.AsString())
+	}
+	if v, _ := attr(playerCall, AttrRole); v.AsString() != "seer" {
+		t.Errorf("role = %q，期望 seer", v.AsString())
+	}
+
+	modelSpan := spanByName(t, spans, "model.chat")
+	if v, _ := attr(modelSpan, AttrModel); v.AsString() != "test-model" {
+		t.Errorf("model = %q，期望 test-model", v.AsString())
+	}
+	if v, _ := attr(modelSpan, AttrInTokens); v.AsInt64() != 120 {
+		t.Errorf("input tokens = %d，期望 120", v.AsInt64())
+	}
+	if v, _ := attr(modelSpan, AttrOutTokens); v.AsInt64() != 30 {
+		t.Errorf("output tokens = %d，期望 30", v.AsInt64())
+	}
+	if !modelSpan.EndTime.After(modelSpan.StartTime) {
+		t.Errorf("模型 span 没有记录耗时")
+	}
+
+	var failed int
+	for _, s := range spans {
+		if s.Name != "tool.check_identity" {
+			continue
+		}
+		if v, _ := attr(s, AttrTool); v.AsString() != "check_identity" {
+			t.Errorf("tool = %q，期望 check_identity", v.AsString())
+		}
+		if s.Status.Code == codes.Error {
+			failed++
+		}
+	}
+	if failed != 1 {
+		t.Errorf("失败的工具 span 有 %d 个，期望 1 个", failed)
+	}
+
+	metrics := scrape(t, reg)
+	for _, want := range []string{
+		`werewolf_tool_calls_total{tool="check_identity"} 2`,
+		`werewolf_errors_total{source="tool"} 1`,
+		`werewolf_tokens_total{model="test-model",type="prompt"} 120`,
+		`werewolf_tokens_total{model="test-model",type="completion"} 30`,
+		`werewolf_model_call_duration_seconds_count{model="test-model"} 1`,
+	} {
+		if !strings.Contains(metrics, want) {
+			t.Errorf("/metrics 中缺少 %s", want)
+		}
+	}
+}
+
+func TestRecordersExposeCounters(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(collectors()...)
+
+	RecordGame("")
+	RecordFallback()
+	RecordCache(true)
+	RecordCache(false)
+	RecordCacheError()
+	ObservePlayerCall("witch", 2*time.Second)
+
+	metrics := scrape(t, reg)
+	for _, want := range []string{
+		`werewolf_games_total{winner="none"} 1`,
+		`werewolf_fallbacks_total 1`,
+		`werewolf_llm_cache_total{result="hit"} 1`,
+		`werewolf_llm_cache_total{result="miss"} 1`,
+		`werewolf_llm_cache_errors_total 1`,
+		`werewolf_player_call_duration_seconds_count{role="witch"} 1`,
+	} {
+		if !strings.Contains(metrics, want) {
+			t.Errorf("/metrics 中缺少 %s", want)
+		}
+	}
+}
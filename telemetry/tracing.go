@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// serviceName 上报的服务名
+const serviceName = "wolf-go-adk"
+
+// span 属性
+const (
+	AttrGameID    = attribute.Key("werewolf.game_id")
+	AttrSeed      = attribute.Key("werewolf.seed")
+	AttrRound     = attribute.Key("werewolf.round")
+	AttrPhase     = attribute.Key("werewolf.phase")
+	AttrSeat      = attribute.Key("werewolf.seat")
+	AttrRole      = attribute.Key("werewolf.role")
+	AttrWinner    = attribute.Key("werewolf.winner")
+	AttrTool      = attribute.Key("werewolf.tool")
+	AttrModel     = attribute.Key("gen_ai.request.model")
+	AttrInTokens  = attribute.Key("gen_ai.usage.input_tokens")
+	AttrOutTokens = attribute.Key("gen_ai.usage.output_tokens")
+)
+
+// NewTracerProvider 使用指定导出器创建 TracerProvider
+// 测试时可以传入 tracetest.NewInMemoryExporter()，调用 ForceFlush 后读取导出的 span
+func NewTracerProvider(exporter sdktrace.SpanExporter) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+}
+
+// newExporter 按名称创建链路导出器
+func newExporter(ctx context.Context, name string) (sdktrace.SpanExporter, error) {
+	switch name {
+	case "otlp":
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("创建 OTLP 导出器失败: %w", err)
+		}
+		return exporter, nil
+	case "stdout":
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stderr))
+		if err != nil {
+			return nil, fmt.Errorf("创建 stdout 导出器失败: %w", err)
+		}
+		return exporter, nil
+	default:
+		return nil, fmt.Errorf("不支持的链路导出方式: %s（可选 otlp / stdout）", name)
+	}
+}
+
+// StartSpan 开始一个 span，未配置导出器时为空操作
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(serviceName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan 结束 span，err 不为空时标记为失败
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -18,350 +18,543 @@ package tools
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/cloudwego/eino/components/tool"
 	"github.com/cloudwego/eino/components/tool/utils"
+	"github.com/cloudwego/eino/schema"
+	"github.com/eino-contrib/jsonschema"
 
 	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
 )
 
+// 行动工具只校验目标并回报玩家的决定，不修改游戏状态；
+// 主持人从回复中取出决定，经 GameState.CheckTarget 校验后统一结算
+
+// rejectTarget 把 CheckTarget 的错误转换为原因代码和按本局语言生成的说明（含可选目标）
+func rejectTarget(state *game.GameState, locale *params.Locale, err error) (game.Violation, string) {
+	var illegal *game.TargetError
+	if !errors.As(err, &illegal) {
+		return "", err.Error()
+	}
+	return illegal.Reason, locale.IllegalTarget(state, illegal)
+}
+
+// inferTool 创建使用本局语言说明的工具，参数说明按 JSON 字段名填入推断出的参数结构
+func inferTool[T, D any](name string, spec params.ToolSpec, fn utils.InvokeFunc[T, D]) tool.BaseTool {
+	info, err := utils.GoStruct2ToolInfo[T](name, spec.Desc)
+	if err != nil {
+		panic(fmt.Errorf("create %s tool failed: %w", name, err))
+	}
+	js, err := info.ParamsOneOf.ToJSONSchema()
+	if err != nil {
+		panic(fmt.Errorf("create %s tool failed: %w", name, err))
+	}
+	describeParams(js, spec.Params)
+	info.ParamsOneOf = schema.NewParamsOneOfByJSONSchema(js)
+	return utils.NewTool(info, fn)
+}
+
+// describeParams 为参数结构中的字段（包括数组元素里的字段）填入说明
+func describeParams(js *jsonschema.Schema, descs map[string]string) {
+	if js == nil {
+		return
+	}
+	if js.Properties != nil {
+		for pair := js.Properties.Oldest(); pair != nil; pair = pair.Next() {
+			if desc, ok := descs[pair.Key]; ok {
+				pair.Value.Description = desc
+			}
+			describeParams(pair.Value, descs)
+		}
+	}
+	describeParams(js.Items, descs)
+}
+
 // ========== 狼人工具 ==========
 
 // DiscussInput 狼人讨论输入
 type DiscussInput struct {
-	Message        string `json:"message" jsonschema:"description=你想对其他狼人说的话"`
-	ReachAgreement bool   `json:"reach_agreement" jsonschema:"description=是否已达成一致意见"`
+	Target  string `json:"target"`
+	Message string `json:"message"`
 }
 
 // DiscussOutput 狼人讨论输出
 type DiscussOutput struct {
-	Success bool   `json:"success"`
-	Message string `json:"message"`
+	Success bool           `json:"success"`
+	Target  string         `json:"target"`
+	Message string         `json:"message"`
+	Reason  game.Violation `json:"reason,omitempty"`
 }
 
-// NewDiscussTool 创建狼人讨论工具
-func NewDiscussTool() tool.BaseTool {
+// NewDiscussTool 创建狼人讨论工具，提议的目标按狼人击杀规则校验
+func NewDiscussTool(state *game.GameState, locale *params.Locale) tool.BaseTool {
+	text := &locale.I18n.Tools
 	fn := func(ctx context.Context, input *DiscussInput) (*DiscussOutput, error) {
+		if input.Target != "" {
+			if err := state.CheckTarget(game.ActionWolfVote, "", input.Target); err != nil {
+				reason, message := rejectTarget(state, locale, err)
+				return &DiscussOutput{
+					Success: false,
+					Message: message,
+					Reason:  reason,
+				}, nil
+			}
+		}
 		return &DiscussOutput{
 			Success: true,
+			Target:  input.Target,
 			Message: input.Message,
 		}, nil
 	}
 
-	t, err := utils.InferTool("discuss", "狼人内部讨论工具，用于与其他狼人交流并决定击杀目标", fn)
-	if err != nil {
-		panic(fmt.Errorf("create discuss tool failed: %w", err))
-	}
-	return t
+	return inferTool("discuss", text.Discuss, fn)
 }
 
 // KillInput 狼人击杀输入
 type KillInput struct {
-	Target string `json:"target" jsonschema:"description=要击杀的玩家名"`
+	Target string `json:"target"`
 }
 
 // KillOutput 狼人击杀输出
 type KillOutput struct {
-	Success bool   `json:"success"`
-	Target  string `json:"target"`
-	Message string `json:"message"`
+	Success bool           `json:"success"`
+	Target  string         `json:"target"`
+	Message string         `json:"message"`
+	Reason  game.Violation `json:"reason,omitempty"`
 }
 
 // NewKillTool 创建狼人击杀工具
-func NewKillTool(state *game.GameState) tool.BaseTool {
+func NewKillTool(state *game.GameState, locale *params.Locale) tool.BaseTool {
+	text := &locale.I18n.Tools
 	fn := func(ctx context.Context, input *KillInput) (*KillOutput, error) {
-		if !state.IsAlive(input.Target) {
+		if err := state.CheckTarget(game.ActionWolfVote, "", input.Target); err != nil {
+			reason, message := rejectTarget(state, locale, err)
 			return &KillOutput{
 				Success: false,
-				Message: fmt.Sprintf("目标 %s 已死亡", input.Target),
-			}, nil
-		}
-		if state.GetPlayerRole(input.Target) == game.RoleWerewolf {
-			return &KillOutput{
-				Success: false,
-				Message: "不能击杀同伴狼人",
+				Message: message,
+				Reason:  reason,
 			}, nil
 		}
 
-		state.SetNightKilled(input.Target)
 		return &KillOutput{
 			Success: true,
 			Target:  input.Target,
-			Message: fmt.Sprintf("决定击杀 %s", input.Target),
+			Message: fmt.Sprintf(text.Killed, input.Target),
 		}, nil
 	}
 
-	t, err := utils.InferTool("kill", "狼人击杀工具，用于选择今晚要击杀的玩家", fn)
-	if err != nil {
-		panic(fmt.Errorf("create kill tool failed: %w", err))
-	}
-	return t
+	return inferTool("kill", text.Kill, fn)
 }
 
 // ========== 预言家工具 ==========
 
 // CheckInput 预言家查验输入
 type CheckInput struct {
-	Target string `json:"target" jsonschema:"description=要查验的玩家名"`
+	Target string `json:"target"`
 }
 
 // CheckOutput 预言家查验输出
 type CheckOutput struct {
-	Target  string `json:"target"`
-	IsWolf  bool   `json:"is_wolf"`
-	Message string `json:"message"`
+	Target  string         `json:"target"`
+	IsWolf  bool           `json:"is_wolf"`
+	Role    string         `json:"role,omitempty"` // 板子按身份查验时返回目标显示的身份
+	Message string         `json:"message"`
+	Reason  game.Violation `json:"reason,omitempty"`
 }
 
-// NewCheckTool 创建预言家查验工具
-func NewCheckTool(state *game.GameState) tool.BaseTool {
+// NewCheckTool 创建预言家查验工具，结果的粒度与主持人告知预言家的一致（见 GameState.CheckIdentity）
+func NewCheckTool(seer string, state *game.GameState, locale *params.Locale) tool.BaseTool {
+	text := &locale.I18n.Tools
 	fn := func(ctx context.Context, input *CheckInput) (*CheckOutput, error) {
-		if !state.IsAlive(input.Target) {
+		if err := state.CheckTarget(game.ActionCheck, seer, input.Target); err != nil {
+			reason, message := rejectTarget(state, locale, err)
 			return &CheckOutput{
 				Target:  input.Target,
-				Message: fmt.Sprintf("目标 %s 已死亡，无法查验", input.Target),
+				Message: message,
+				Reason:  reason,
 			}, nil
 		}
 
-		role := state.GetPlayerRole(input.Target)
-		isWolf := role == game.RoleWerewolf
-
-		result := "好人"
-		if isWolf {
-			result = "狼人"
-		}
-
+		check := state.CheckIdentity(input.Target)
 		return &CheckOutput{
 			Target:  input.Target,
-			IsWolf:  isWolf,
-			Message: fmt.Sprintf("查验结果：%s 是 %s", input.Target, result),
+			IsWolf:  check.IsWolf(),
+			Role:    string(check.Role),
+			Message: fmt.Sprintf(locale.Prompts.ToSeerResult, input.Target, locale.I18n.Log.CheckResultName(check)),
 		}, nil
 	}
 
-	t, err := utils.InferTool("check_identity", "预言家查验工具，用于查验一名玩家的身份", fn)
-	if err != nil {
-		panic(fmt.Errorf("create check tool failed: %w", err))
-	}
-	return t
+	return inferTool("check_identity", text.Check, fn)
 }
 
 // ========== 女巫工具 ==========
 
 // SaveInput 女巫救人输入
 type SaveInput struct {
-	Save bool `json:"save" jsonschema:"description=是否使用解药救人"`
+	Save bool `json:"save"`
 }
 
 // SaveOutput 女巫救人输出
 type SaveOutput struct {
-	Success bool   `json:"success"`
-	Saved   string `json:"saved"`
-	Message string `json:"message"`
+	Success bool           `json:"success"`
+	Saved   string         `json:"saved"`
+	Message string         `json:"message"`
+	Reason  game.Violation `json:"reason,omitempty"`
 }
 
 // NewSaveTool 创建女巫救人工具
-func NewSaveTool(state *game.GameState) tool.BaseTool {
+func NewSaveTool(witch string, state *game.GameState, locale *params.Locale) tool.BaseTool {
+	text := &locale.I18n.Tools
 	fn := func(ctx context.Context, input *SaveInput) (*SaveOutput, error) {
-		if !state.CanUseHealingPotion() {
-			return &SaveOutput{
-				Success: false,
-				Message: "解药已用完",
-			}, nil
-		}
-
 		killed := state.GetNightKilled()
 		if killed == "" {
 			return &SaveOutput{
 				Success: false,
-				Message: "今晚没有人被狼人击杀",
+				Message: text.NoNightKill,
 			}, nil
 		}
 
-		// 女巫不能自救
-		if killed == state.Witch {
+		// 解药必须可用，女巫不能自救
+		if err := state.CheckTarget(game.ActionSave, witch, killed); err != nil {
+			reason, message := rejectTarget(state, locale, err)
 			return &SaveOutput{
 				Success: false,
-				Message: "女巫不能自救",
+				Message: message,
+				Reason:  reason,
 			}, nil
 		}
 
 		if input.Save {
-			state.SetNightSaved(true)
 			return &SaveOutput{
 				Success: true,
 				Saved:   killed,
-				Message: fmt.Sprintf("使用解药救活了 %s", killed),
+				Message: fmt.Sprintf(text.Saved, killed),
 			}, nil
 		}
 
 		return &SaveOutput{
 			Success: true,
-			Message: "选择不使用解药",
+			Message: text.NoSave,
 		}, nil
 	}
 
-	t, err := utils.InferTool("save", "女巫救人工具，用于使用解药救活被狼人击杀的玩家", fn)
-	if err != nil {
-		panic(fmt.Errorf("create save tool failed: %w", err))
-	}
-	return t
+	return inferTool("save", text.Save, fn)
 }
 
 // PoisonInput 女巫毒人输入
 type PoisonInput struct {
-	Poison bool   `json:"poison" jsonschema:"description=是否使用毒药"`
-	Target string `json:"target" jsonschema:"description=要毒杀的玩家名（如果使用毒药）"`
+	Poison bool   `json:"poison"`
+	Target string `json:"target"`
 }
 
 // PoisonOutput 女巫毒人输出
 type PoisonOutput struct {
-	Success  bool   `json:"success"`
-	Poisoned string `json:"poisoned"`
-	Message  string `json:"message"`
+	Success  bool           `json:"success"`
+	Poisoned string         `json:"poisoned"`
+	Message  string         `json:"message"`
+	Reason   game.Violation `json:"reason,omitempty"`
 }
 
 // NewPoisonTool 创建女巫毒人工具
-func NewPoisonTool(state *game.GameState) tool.BaseTool {
+func NewPoisonTool(witch string, state *game.GameState, locale *params.Locale) tool.BaseTool {
+	text := &locale.I18n.Tools
 	fn := func(ctx context.Context, input *PoisonInput) (*PoisonOutput, error) {
 		if !state.CanUsePoisonPotion() {
 			return &PoisonOutput{
 				Success: false,
-				Message: "毒药已用完",
+				Message: text.NoPoisonLeft,
 			}, nil
 		}
 
 		if !input.Poison {
 			return &PoisonOutput{
 				Success: true,
-				Message: "选择不使用毒药",
+				Message: text.NoPoison,
 			}, nil
 		}
 
 		if input.Target == "" {
 			return &PoisonOutput{
 				Success: false,
-				Message: "请指定毒杀目标",
-			}, nil
-		}
-
-		if !state.IsAlive(input.Target) {
-			return &PoisonOutput{
-				Success: false,
-				Message: fmt.Sprintf("目标 %s 已死亡", input.Target),
+				Message: text.NoPoisonTarget,
 			}, nil
 		}
 
 		// 女巫不能毒自己
-		if input.Target == state.Witch {
+		if err := state.CheckTarget(game.ActionPoison, witch, input.Target); err != nil {
+			reason, message := rejectTarget(state, locale, err)
 			return &PoisonOutput{
 				Success: false,
-				Message: "女巫不能毒自己",
+				Message: message,
+				Reason:  reason,
 			}, nil
 		}
 
-		state.SetNightPoisoned(input.Target)
 		return &PoisonOutput{
 			Success:  true,
 			Poisoned: input.Target,
-			Message:  fmt.Sprintf("使用毒药毒杀了 %s", input.Target),
+			Message:  fmt.Sprintf(text.Poisoned, input.Target),
 		}, nil
 	}
 
-	t, err := utils.InferTool("poison", "女巫毒人工具，用于使用毒药毒杀一名玩家", fn)
-	if err != nil {
-		panic(fmt.Errorf("create poison tool failed: %w", err))
-	}
-	return t
+	return inferTool("poison", text.Poison, fn)
 }
 
 // ========== 猎人工具 ==========
 
 // ShootInput 猎人开枪输入
 type ShootInput struct {
-	Shoot  bool   `json:"shoot" jsonschema:"description=是否开枪"`
-	Target string `json:"target" jsonschema:"description=要射杀的玩家名（如果开枪）"`
+	Shoot  bool   `json:"shoot"`
+	Target string `json:"target"`
 }
 
 // ShootOutput 猎人开枪输出
 type ShootOutput struct {
-	Success bool   `json:"success"`
-	Shot    string `json:"shot"`
-	Message string `json:"message"`
+	Success bool           `json:"success"`
+	Shot    string         `json:"shot"`
+	Message string         `json:"message"`
+	Reason  game.Violation `json:"reason,omitempty"`
 }
 
 // NewShootTool 创建猎人开枪工具
-func NewShootTool(state *game.GameState) tool.BaseTool {
+func NewShootTool(hunter string, state *game.GameState, locale *params.Locale) tool.BaseTool {
+	text := &locale.I18n.Tools
 	fn := func(ctx context.Context, input *ShootInput) (*ShootOutput, error) {
 		if !input.Shoot {
 			return &ShootOutput{
 				Success: true,
-				Message: "选择不开枪",
+				Message: text.NoShot,
 			}, nil
 		}
 
 		if input.Target == "" {
 			return &ShootOutput{
 				Success: false,
-				Message: "请指定射杀目标",
+				Message: text.NoShootTarget,
 			}, nil
 		}
 
-		if !state.IsAlive(input.Target) {
+		if err := state.CheckTarget(game.ActionShoot, hunter, input.Target); err != nil {
+			reason, message := rejectTarget(state, locale, err)
 			return &ShootOutput{
 				Success: false,
-				Message: fmt.Sprintf("目标 %s 已死亡", input.Target),
+				Message: message,
+				Reason:  reason,
 			}, nil
 		}
 
-		state.KillPlayer(input.Target)
 		return &ShootOutput{
 			Success: true,
 			Shot:    input.Target,
-			Message: fmt.Sprintf("猎人开枪射杀了 %s", input.Target),
+			Message: fmt.Sprintf(text.Shot, input.Target),
 		}, nil
 	}
 
-	t, err := utils.InferTool("shoot", "猎人开枪工具，被淘汰时可以开枪带走一名玩家", fn)
-	if err != nil {
-		panic(fmt.Errorf("create shoot tool failed: %w", err))
-	}
-	return t
+	return inferTool("shoot", text.Shoot, fn)
 }
 
 // ========== 投票工具 ==========
 
 // VoteInput 投票输入
 type VoteInput struct {
-	Target string `json:"target" jsonschema:"description=投票淘汰的玩家名"`
+	Target  string `json:"target,omitempty"`
+	Abstain bool   `json:"abstain,omitempty"`
 }
 
 // VoteOutput 投票输出
 type VoteOutput struct {
-	Success bool   `json:"success"`
-	Target  string `json:"target"`
-	Message string `json:"message"`
+	Success bool           `json:"success"`
+	Target  string         `json:"target"`
+	Abstain bool           `json:"abstain,omitempty"`
+	Message string         `json:"message"`
+	Reason  game.Violation `json:"reason,omitempty"`
 }
 
-// NewVoteTool 创建投票工具
-func NewVoteTool(state *game.GameState) tool.BaseTool {
+// NewVoteTool 创建投票工具，夜间按狼人击杀投票校验目标，白天按放逐投票校验目标
+func NewVoteTool(player string, state *game.GameState, locale *params.Locale) tool.BaseTool {
+	text := &locale.I18n.Tools
 	fn := func(ctx context.Context, input *VoteInput) (*VoteOutput, error) {
-		if !state.IsAlive(input.Target) {
+		night := state.GetPhase() == "night"
+		if input.Abstain && !night {
+			return &VoteOutput{
+				Success: true,
+				Abstain: true,
+				Message: text.Abstained,
+			}, nil
+		}
+
+		action := game.ActionVote
+		if night {
+			action = game.ActionWolfVote
+		}
+		if err := state.CheckTarget(action, player, input.Target); err != nil {
+			reason, message := rejectTarget(state, locale, err)
 			return &VoteOutput{
 				Success: false,
-				Message: fmt.Sprintf("目标 %s 已死亡，无法投票", input.Target),
+				Message: message,
+				Reason:  reason,
 			}, nil
 		}
 
 		return &VoteOutput{
 			Success: true,
 			Target:  input.Target,
-			Message: fmt.Sprintf("投票淘汰 %s", input.Target),
+			Message: fmt.Sprintf(text.Voted, input.Target),
 		}, nil
 	}
 
-	t, err := utils.InferTool("vote", "投票工具，用于在白天投票淘汰玩家", fn)
-	if err != nil {
-		panic(fmt.Errorf("create vote tool failed: %w", err))
+	return inferTool("vote", text.Vote, fn)
+}
+
+// ========== 发言方向工具 ==========
+
+// DirectionInput 警长决定发言方向的输入
+type DirectionInput struct {
+	Direction string `json:"direction" jsonschema:"enum=clockwise,enum=counterclockwise"`
+}
+
+// DirectionOutput 警长决定发言方向的输出
+type DirectionOutput struct {
+	Success   bool   `json:"success"`
+	Direction string `json:"direction"`
+	Message   string `json:"message"`
+}
+
+// NewDirectionTool 创建发言方向工具，警长从 clockwise 和 counterclockwise 中选择今天的发言方向
+func NewDirectionTool(locale *params.Locale) tool.BaseTool {
+	text := &locale.I18n.Tools
+	fn := func(ctx context.Context, input *DirectionInput) (*DirectionOutput, error) {
+		direction := params.SpeakingOrder(strings.ToLower(strings.TrimSpace(input.Direction)))
+		var name string
+		switch direction {
+		case params.OrderClockwise:
+			name = locale.I18n.Clockwise
+		case params.OrderCounterClockwise:
+			name = locale.I18n.CounterClockwise
+		default:
+			return &DirectionOutput{
+				Success: false,
+				Message: text.BadDirection,
+			}, nil
+		}
+		return &DirectionOutput{
+			Success:   true,
+			Direction: string(direction),
+			Message:   fmt.Sprintf(text.DirectionChosen, name),
+		}, nil
 	}
-	return t
+
+	return inferTool("direction", text.Direction, fn)
+}
+
+// ========== 发言工具 ==========
+
+// SpeechCheck 发言中声称的查验结果
+type SpeechCheck struct {
+	Target string `json:"target"`
+	IsWolf bool   `json:"is_wolf"`
+}
+
+// SpeechInput 结构化发言输入，与自由文本发言一起提交
+type SpeechInput struct {
+	ClaimRole string        `json:"claim_role,omitempty"`
+	Checks    []SpeechCheck `json:"checks,omitempty"`
+	Suspects  []string      `json:"suspects,omitempty"`
+	Trusted   []string      `json:"trusted,omitempty"`
+}
+
+// SpeechOutput 结构化发言输出
+type SpeechOutput struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// NewSpeechTool 创建结构化发言工具
+// 玩家可以在白天发言时调用，把身份声明、查验结果、怀疑和信任的玩家以结构化形式提交，
+// 主持人在发言结束后将其记录为事件；不合法的角色和玩家名会被忽略
+func NewSpeechTool(player string, state *game.GameState, locale *params.Locale) tool.BaseTool {
+	text := &locale.I18n.Tools
+	fn := func(ctx context.Context, input *SpeechInput) (*SpeechOutput, error) {
+		claim := game.SpeechClaim{}
+
+		role := game.Role(strings.ToLower(strings.TrimSpace(input.ClaimRole)))
+		switch role {
+		case game.RoleWerewolf, game.RoleVillager, game.RoleSeer, game.RoleWitch, game.RoleHunter:
+			claim.Role = role
+		}
+
+		for _, c := range input.Checks {
+			if state.HasPlayer(c.Target) && c.Target != player {
+				claim.Checks = append(claim.Checks, game.ClaimedCheck{Target: c.Target, IsWolf: c.IsWolf})
+			}
+		}
+		claim.Suspects = validPlayers(state, player, input.Suspects)
+		claim.Trusted = validPlayers(state, player, input.Trusted)
+
+		state.SetPendingClaim(player, claim)
+		return &SpeechOutput{
+			Success: true,
+			Message: text.SpeechRecorded,
+		}, nil
+	}
+
+	return inferTool("speech", text.Speech, fn)
+}
+
+// validPlayers 过滤掉不存在的玩家和玩家自己
+func validPlayers(state *game.GameState, self string, names []string) []string {
+	var result []string
+	for _, name := range names {
+		if state.HasPlayer(name) && name != self {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// ========== 概率判断工具 ==========
+
+// BeliefInput 概率判断输入
+type BeliefInput struct {
+	Probs map[string]float64 `json:"probs"`
+}
+
+// BeliefOutput 概率判断输出
+type BeliefOutput struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// NewBeliefTool 创建概率判断工具
+// 玩家随时可以调用，提交自己认为其他玩家是狼人的概率，用于赛后评估推理质量；
+// 不存在的玩家、玩家自己以及超出 [0, 1] 的概率会被忽略
+func NewBeliefTool(player string, state *game.GameState, locale *params.Locale) tool.BaseTool {
+	text := &locale.I18n.Tools
+	fn := func(ctx context.Context, input *BeliefInput) (*BeliefOutput, error) {
+		probs := make(map[string]float64)
+		for name, p := range input.Probs {
+			if !state.HasPlayer(name) || name == player || p < 0 || p > 1 {
+				continue
+			}
+			probs[name] = p
+		}
+		if len(probs) == 0 {
+			return &BeliefOutput{
+				Success: false,
+				Message: text.NoBeliefs,
+			}, nil
+		}
+
+		state.RecordBelief(player, probs)
+		return &BeliefOutput{
+			Success: true,
+			Message: fmt.Sprintf(text.BeliefsRecorded, len(probs)),
+		}, nil
+	}
+
+	return inferTool("belief", text.Belief, fn)
 }
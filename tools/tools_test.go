@@ -0,0 +1,216 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/eino/components/tool"
+
+	"github.com/ashwinyue/wolf-go-adk/game"
+	"github.com/ashwinyue/wolf-go-adk/params"
+)
+
+// newToolState 四人局：Player1 狼人，Player2 预言家，Player3 女巫，Player4 村民
+func newToolState() *game.GameState {
+	state := game.NewGameState()
+	state.InitPlayers(
+		[]string{"Player1", "Player2", "Player3", "Player4"},
+		[]game.Role{game.RoleWerewolf, game.RoleSeer, game.RoleWitch, game.RoleVillager},
+	)
+	return state
+}
+
+// invoke 以 JSON 参数调用工具并解析输出
+func invoke(t *testing.T, bt tool.BaseTool, args string, out interface{}) {
+	t.Helper()
+	resp, err := bt.(tool.InvokableTool).InvokableRun(context.Background(), args)
+	if err != nil {
+		t.Fatalf("调用工具失败: %v", err)
+	}
+	if err := json.Unmarshal([]byte(resp), out); err != nil {
+		t.Fatalf("解析工具输出 %q 失败: %v", resp, err)
+	}
+}
+
+func TestVoteToolRejectsInLocale(t *testing.T) {
+	for _, lang := range []string{"zh", "en", "ja"} {
+		t.Run(lang, func(t *testing.T) {
+			locale := params.NewLocale(lang)
+			state := newToolState()
+			state.SetPhase("day")
+
+			var out VoteOutput
+			invoke(t, NewVoteTool("Player2", state, locale), `{"target":"Player2"}`, &out)
+
+			want := fmt.Sprintf(locale.Prompts.ToIllegalTarget, `"Player2"`, locale.Prompts.ToIllegalSelf, "Player1, Player3, Player4")
+			if out.Success || out.Reason != game.ViolationSelf || out.Message != want {
+				t.Errorf("投票给自己 = %+v，期望原因 %s、说明 %q", out, game.ViolationSelf, want)
+			}
+		})
+	}
+}
+
+func TestVoteToolFollowsPhase(t *testing.T) {
+	state := newToolState()
+	vote := NewVoteTool("Player1", state, params.NewLocale("zh"))
+
+	var out VoteOutput
+	invoke(t, vote, `{"abstain":true}`, &out)
+	if out.Success || out.Reason != game.ViolationMissing {
+		t.Errorf("夜间弃票 = %+v，期望按狼人击杀校验", out)
+	}
+
+	state.SetPhase("day")
+	out = VoteOutput{}
+	invoke(t, vote, `{"abstain":true}`, &out)
+	if !out.Success || !out.Abstain {
+		t.Errorf("白天弃票 = %+v，期望成功", out)
+	}
+}
+
+func TestCheckToolResultInLocale(t *testing.T) {
+	tests := []struct {
+		name   string
+		mode   game.CheckMode
+		target string
+		result game.CheckResult
+	}{
+		{"按阵营查验狼人", game.CheckFaction, "Player1", game.CheckResult{Target: "Player1", Faction: game.FactionWerewolf}},
+		{"按阵营查验好人", game.CheckFaction, "Player3", game.CheckResult{Target: "Player3", Faction: game.FactionVillager}},
+		{"按身份查验", game.CheckRole, "Player3", game.CheckResult{Target: "Player3", Faction: game.FactionVillager, Role: game.RoleWitch}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locale := params.NewLocale("en")
+			state := newToolState()
+			state.SetRules(game.Rules{SeerCheck: tt.mode})
+
+			var out CheckOutput
+			invoke(t, NewCheckTool("Player2", state, locale), fmt.Sprintf(`{"target":%q}`, tt.target), &out)
+
+			want := fmt.Sprintf(locale.Prompts.ToSeerResult, tt.target, locale.I18n.Log.CheckResultName(tt.result))
+			if out.Message != want || out.IsWolf != tt.result.IsWolf() || out.Role != string(tt.result.Role) {
+				t.Errorf("查验结果 = %+v，期望说明 %q", out, want)
+			}
+		})
+	}
+}
+
+func TestCheckToolRejectsSelf(t *testing.T) {
+	var out CheckOutput
+	invoke(t, NewCheckTool("Player2", newToolState(), params.NewLocale("en")), `{"target":"Player2"}`, &out)
+	if out.Reason != game.ViolationSelf {
+		t.Errorf("查验自己 = %+v，期望原因 %s", out, game.ViolationSelf)
+	}
+}
+
+func TestToolsDescribedInLocale(t *testing.T) {
+	for _, lang := range []string{"zh", "en", "ja"} {
+		t.Run(lang, func(t *testing.T) {
+			locale := params.NewLocale(lang)
+			text := &locale.I18n.Tools
+			state := newToolState()
+			tests := []struct {
+				tool tool.BaseTool
+				spec params.ToolSpec
+			}{
+				{NewDiscussTool(state, locale), text.Discuss},
+				{NewKillTool(state, locale), text.Kill},
+				{NewCheckTool("Player2", state, locale), text.Check},
+				{NewSaveTool("Player3", state, locale), text.Save},
+				{NewPoisonTool("Player3", state, locale), text.Poison},
+				{NewShootTool("Player4", state, locale), text.Shoot},
+				{NewVoteTool("Player4", state, locale), text.Vote},
+				{NewSpeechTool("Player4", state, locale), text.Speech},
+				{NewBeliefTool("Player4", state, locale), text.Belief},
+				{NewDirectionTool(locale), text.Direction},
+			}
+			for _, tt := range tests {
+				info, err := tt.tool.Info(context.Background())
+				if err != nil {
+					t.Fatalf("读取工具定义失败: %v", err)
+				}
+				if info.Desc == "" || info.Desc != tt.spec.Desc {
+					t.Errorf("%s 的说明 = %q，期望 %q", info.Name, info.Desc, tt.spec.Desc)
+				}
+				js, err := info.ParamsOneOf.ToJSONSchema()
+				if err != nil {
+					t.Fatalf("读取 %s 的参数结构失败: %v", info.Name, err)
+				}
+				for pair := js.Properties.Oldest(); pair != nil; pair = pair.Next() {
+					if want := tt.spec.Params[pair.Key]; want == "" || pair.Value.Description != want {
+						t.Errorf("%s.%s 的说明 = %q，期望 %q", info.Name, pair.Key, pair.Value.Description, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestToolResultsInLocale(t *testing.T) {
+	locale := params.NewLocale("en")
+	text := &locale.I18n.Tools
+	state := newToolState()
+	state.SetPhase("day")
+
+	var vote VoteOutput
+	invoke(t, NewVoteTool("Player2", state, locale), `{"target":"Player1"}`, &vote)
+	if want := fmt.Sprintf(text.Voted, "Player1"); vote.Message != want {
+		t.Errorf("投票结果 = %q，期望 %q", vote.Message, want)
+	}
+
+	var save SaveOutput
+	invoke(t, NewSaveTool("Player3", state, locale), `{"save":true}`, &save)
+	if save.Success || save.Message != text.NoNightKill {
+		t.Errorf("没有狼刀时救人 = %+v，期望 %q", save, text.NoNightKill)
+	}
+
+	var shoot ShootOutput
+	invoke(t, NewShootTool("Player4", state, locale), `{"shoot":false}`, &shoot)
+	if shoot.Message != text.NoShot {
+		t.Errorf("不开枪 = %q，期望 %q", shoot.Message, text.NoShot)
+	}
+}
+
+func TestDirectionTool(t *testing.T) {
+	locale := params.NewLocale("en")
+	text := &locale.I18n.Tools
+	tests := []struct {
+		args      string
+		success   bool
+		direction string
+	}{
+		{`{"direction":"clockwise"}`, true, "clockwise"},
+		{`{"direction":"Counterclockwise"}`, true, "counterclockwise"},
+		{`{"direction":"left"}`, false, ""},
+		{`{}`, false, ""},
+	}
+	for _, tt := range tests {
+		var out DirectionOutput
+		invoke(t, NewDirectionTool(locale), tt.args, &out)
+		if out.Success != tt.success || out.Direction != tt.direction {
+			t.Errorf("direction(%s) = %+v，期望 success=%v direction=%q", tt.args, out, tt.success, tt.direction)
+		}
+		if !tt.success && out.Message != text.BadDirection {
+			t.Errorf("direction(%s) 的提示 = %q，期望 %q", tt.args, out.Message, text.BadDirection)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/telemetry"
+)
+
+// ResponseCache 按内容寻址的模型响应磁盘缓存
+// 键由提供方、模型名、创建模型时的配置、调用参数、绑定的工具和完整消息列表计算得到，相同输入直接返回之前的响应
+type ResponseCache struct {
+	dir    string
+	hits   atomic.Int64
+	misses atomic.Int64
+	failed atomic.Int64
+}
+
+var (
+	responseCache     *ResponseCache
+	responseCacheOnce sync.Once
+	cacheDisabled     atomic.Bool
+)
+
+// NewResponseCache 创建缓存，响应保存在 dir/<键前两位>/<键>.json
+func NewResponseCache(dir string) *ResponseCache {
+	return &ResponseCache{dir: dir}
+}
+
+// SharedResponseCache 返回进程共享的缓存：设置了 LLM_CACHE_DIR 且未调用 DisableResponseCache 时启用，否则返回 nil
+func SharedResponseCache() *ResponseCache {
+	if cacheDisabled.Load() {
+		return nil
+	}
+	responseCacheOnce.Do(func() {
+		if dir := os.Getenv("LLM_CACHE_DIR"); dir != "" {
+			responseCache = NewResponseCache(dir)
+		}
+	})
+	return responseCache
+}
+
+// DisableResponseCache 在本进程中关闭响应缓存（--no-cache），需要在创建模型之前调用
+func DisableResponseCache() {
+	cacheDisabled.Store(true)
+}
+
+// Stats 返回命中、未命中和写入失败次数
+func (c *ResponseCache) Stats() (hits, misses, failed int64) {
+	return c.hits.Load(), c.misses.Load(), c.failed.Load()
+}
+
+// get 读取缓存的响应，不存在或损坏时返回 nil
+func (c *ResponseCache) get(key string) *schema.Message {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil
+	}
+	var msg schema.Message
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil
+	}
+	return &msg
+}
+
+// put 写入响应，先写临时文件再重命名，并发写同一个键时不会读到半个文件
+func (c *ResponseCache) put(key string, msg *schema.Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("序列化缓存响应失败: %w", err)
+	}
+	path := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("创建缓存目录失败: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("写入缓存失败: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("写入缓存失败: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("写入缓存失败: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("写入缓存失败: %w", err)
+	}
+	return nil
+}
+
+func (c *ResponseCache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key+".json")
+}
+
+func (c *ResponseCache) record(hit bool) {
+	if hit {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+	telemetry.RecordCache(hit)
+}
+
+// store 写入响应，失败只计数不中断调用：缓存写不进去时本次响应仍然有效
+func (c *ResponseCache) store(key string, msg *schema.Message) {
+	if err := c.put(key, msg); err != nil {
+		c.failed.Add(1)
+		telemetry.RecordCacheError()
+	}
+}
+
+// cachedChatModel 先查缓存，未命中时调用底层模型并写入缓存
+type cachedChatModel struct {
+	inner    model.ToolCallingChatModel
+	cache    *ResponseCache
+	provider string
+	model    string
+	config   map[string]string
+	tools    []*schema.ToolInfo
+}
+
+// WithResponseCache 用响应缓存包装聊天模型，provider、modelName 和 config 参与缓存键的计算
+// config 是创建模型时确定、不随调用参数传递的配置（接口地址、温度等），修改后不会命中之前的缓存
+func WithResponseCache(cm model.ToolCallingChatModel, cache *ResponseCache, provider, modelName string, config map[string]string) model.ToolCallingChatModel {
+	return &cachedChatModel{inner: cm, cache: cache, provider: provider, model: modelName, config: config}
+}
+
+func (m *cachedChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	key, err := m.key(input, opts)
+	if err != nil {
+		return m.inner.Generate(ctx, input, opts...)
+	}
+	if msg := m.cache.get(key); msg != nil {
+		m.cache.record(true)
+		return msg, nil
+	}
+	m.cache.record(false)
+
+	msg, err := m.inner.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	m.cache.store(key, msg)
+	return msg, nil
+}
+
+func (m *cachedChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	key, err := m.key(input, opts)
+	if err != nil {
+		return m.inner.Stream(ctx, input, opts...)
+	}
+	if msg := m.cache.get(key); msg != nil {
+		m.cache.record(true)
+		return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+	}
+	m.cache.record(false)
+
+	stream, err := m.inner.Stream(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// 边转发边收集分片，完整读完且没有出错时拼接后写入缓存
+	out, w := schema.Pipe[*schema.Message](1)
+	go func() {
+		defer stream.Close()
+		defer w.Close()
+		var chunks []*schema.Message
+		for {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if closed := w.Send(chunk, err); closed || err != nil {
+				return
+			}
+			chunks = append(chunks, chunk)
+		}
+		msg, err := schema.ConcatMessages(chunks)
+		if err != nil {
+			return
+		}
+		m.cache.store(key, msg)
+	}()
+	return out, nil
+}
+
+func (m *cachedChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	inner, err := m.inner.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedChatModel{inner: inner, cache: m.cache, provider: m.provider, model: m.model, config: m.config, tools: tools}, nil
+}
+
+// GetType 沿用底层模型的类型
+func (m *cachedChatModel) GetType() string {
+	typ, _ := components.GetType(m.inner)
+	return typ
+}
+
+// IsCallbacksEnabled 沿用底层模型的设置，避免未命中时回调重复触发；命中时不产生模型回调，只计入缓存指标
+func (m *cachedChatModel) IsCallbacksEnabled() bool {
+	return components.IsCallbacksEnabled(m.inner)
+}
+
+// cacheKey 参与哈希的全部内容
+type cacheKey struct {
+	Provider string            `json:"provider"`
+	Model    string            `json:"model"`
+	Config   map[string]string `json:"config,omitempty"`
+	Options  cacheOptions      `json:"options"`
+	Tools    []cacheTool       `json:"tools"`
+	Messages []*schema.Message `json:"messages"`
+}
+
+type cacheOptions struct {
+	Temperature *float32           `json:"temperature,omitempty"`
+	MaxTokens   *int               `json:"max_tokens,omitempty"`
+	Model       *string            `json:"model,omitempty"`
+	TopP        *float32           `json:"top_p,omitempty"`
+	Stop        []string           `json:"stop,omitempty"`
+	ToolChoice  *schema.ToolChoice `json:"tool_choice,omitempty"`
+}
+
+type cacheTool struct {
+	Name   string `json:"name"`
+	Desc   string `json:"desc"`
+	Params any    `json:"params,omitempty"`
+}
+
+// key 计算缓存键：调用时传入的工具优先于绑定的工具
+func (m *cachedChatModel) key(input []*schema.Message, opts []model.Option) (string, error) {
+	o := model.GetCommonOptions(&model.Options{}, opts...)
+	tools := m.tools
+	if o.Tools != nil {
+		tools = o.Tools
+	}
+
+	k := cacheKey{
+		Provider: m.provider,
+		Model:    m.model,
+		Config:   m.config,
+		Options: cacheOptions{
+			Temperature: o.Temperature,
+			MaxTokens:   o.MaxTokens,
+			Model:       o.Model,
+			TopP:        o.TopP,
+			Stop:        o.Stop,
+			ToolChoice:  o.ToolChoice,
+		},
+		Messages: input,
+	}
+	for _, t := range tools {
+		ct := cacheTool{Name: t.Name, Desc: t.Desc}
+		if t.ParamsOneOf != nil {
+			params, err := t.ParamsOneOf.ToJSONSchema()
+			if err != nil {
+				return "", err
+			}
+			ct.Params = params
+		}
+		k.Tools = append(k.Tools, ct)
+	}
+
+	data, err := json.Marshal(k)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
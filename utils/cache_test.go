@@ -0,0 +1,176 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/telemetry"
+)
+
+// countingModel 记录调用次数，每次返回不同的回复
+type countingModel struct {
+	calls int
+}
+
+func (m *countingModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	m.calls++
+	return schema.AssistantMessage(fmt.Sprintf("reply %d", m.calls), nil), nil
+}
+
+func (m *countingModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, _ := m.Generate(ctx, input, opts...)
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func (m *countingModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return m, nil
+}
+
+// generate 调用一次模型并返回回复内容
+func generate(t *testing.T, cm model.ToolCallingChatModel, prompt string, opts ...model.Option) string {
+	t.Helper()
+	msg, err := cm.Generate(context.Background(), []*schema.Message{schema.UserMessage(prompt)}, opts...)
+	if err != nil {
+		t.Fatalf("Generate() 失败: %v", err)
+	}
+	return msg.Content
+}
+
+// cacheErrors 读取 werewolf_llm_cache_errors_total 的当前值
+func cacheErrors(t *testing.T) float64 {
+	t.Helper()
+	families, err := telemetry.Registry.Gather()
+	if err != nil {
+		t.Fatalf("读取指标失败: %v", err)
+	}
+	for _, f := range families {
+		if f.GetName() == "werewolf_llm_cache_errors_total" {
+			return f.GetMetric()[0].GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+func TestResponseCacheHitAndMiss(t *testing.T) {
+	cache := NewResponseCache(t.TempDir())
+	inner := &countingModel{}
+	cm := WithResponseCache(inner, cache, "openai", "gpt", map[string]string{"OPENAI_BASE_URL": "https://a"})
+
+	first := generate(t, cm, "hello")
+	if again := generate(t, cm, "hello"); again != first {
+		t.Errorf("相同输入返回 %q，期望缓存的 %q", again, first)
+	}
+	if generate(t, cm, "other") == first {
+		t.Error("不同输入命中了缓存")
+	}
+	temperature := float32(0.5)
+	if generate(t, cm, "hello", model.WithTemperature(temperature)) == first {
+		t.Error("调用参数不同时命中了缓存")
+	}
+
+	if inner.calls != 3 {
+		t.Errorf("底层模型调用 %d 次，期望 3 次", inner.calls)
+	}
+	if hits, misses, failed := cache.Stats(); hits != 1 || misses != 3 || failed != 0 {
+		t.Errorf("Stats() = (%d, %d, %d)，期望 (1, 3, 0)", hits, misses, failed)
+	}
+}
+
+func TestResponseCacheKeyIncludesModelConfig(t *testing.T) {
+	cache := NewResponseCache(t.TempDir())
+	inner := &countingModel{}
+	wrap := func(provider, modelName string, config map[string]string) model.ToolCallingChatModel {
+		return WithResponseCache(inner, cache, provider, modelName, config)
+	}
+
+	base := generate(t, wrap("openai", "gpt", map[string]string{"OPENAI_BASE_URL": "https://a"}), "hello")
+	variants := []struct {
+		name string
+		cm   model.ToolCallingChatModel
+	}{
+		{"接口地址不同", wrap("openai", "gpt", map[string]string{"OPENAI_BASE_URL": "https://b"})},
+		{"多出一项配置", wrap("openai", "gpt", map[string]string{"OPENAI_BASE_URL": "https://a", "OPENAI_BY_AZURE": "true"})},
+		{"模型名不同", wrap("openai", "gpt-mini", map[string]string{"OPENAI_BASE_URL": "https://a"})},
+		{"提供方不同", wrap("ark", "gpt", map[string]string{"OPENAI_BASE_URL": "https://a"})},
+	}
+	for _, v := range variants {
+		if generate(t, v.cm, "hello") == base {
+			t.Errorf("%s时命中了缓存", v.name)
+		}
+	}
+	if generate(t, wrap("openai", "gpt", map[string]string{"OPENAI_BASE_URL": "https://a"}), "hello") != base {
+		t.Error("配置相同时没有命中缓存")
+	}
+}
+
+func TestResponseCacheCorruptEntry(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewResponseCache(dir)
+	inner := &countingModel{}
+	cm := WithResponseCache(inner, cache, "openai", "gpt", nil)
+
+	generate(t, cm, "hello")
+	// 把缓存文件改成无效 JSON
+	files, err := filepath.Glob(filepath.Join(dir, "*", "*.json"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("缓存文件 = %v (%v)，期望 1 个", files, err)
+	}
+	if err := os.WriteFile(files[0], []byte("{broken"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := generate(t, cm, "hello"); got != "reply 2" {
+		t.Errorf("缓存损坏时返回 %q，期望重新调用模型得到 reply 2", got)
+	}
+	if got := generate(t, cm, "hello"); got != "reply 2" {
+		t.Errorf("重新调用后返回 %q，期望缓存被覆盖为 reply 2", got)
+	}
+}
+
+func TestResponseCacheWriteFailure(t *testing.T) {
+	// 缓存目录指向一个普通文件，创建子目录必然失败
+	dir := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(dir, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	cache := NewResponseCache(dir)
+	inner := &countingModel{}
+	cm := WithResponseCache(inner, cache, "openai", "gpt", nil)
+
+	before := cacheErrors(t)
+	if got := generate(t, cm, "hello"); got != "reply 1" {
+		t.Errorf("写入失败时返回 %q，期望模型的回复 reply 1", got)
+	}
+	if got := generate(t, cm, "hello"); got != "reply 2" {
+		t.Errorf("写入失败后再次调用返回 %q，期望重新调用模型", got)
+	}
+
+	if _, _, failed := cache.Stats(); failed != 2 {
+		t.Errorf("写入失败次数 = %d，期望 2", failed)
+	}
+	if got := cacheErrors(t) - before; got != 2 {
+		t.Errorf("werewolf_llm_cache_errors_total 增加了 %v，期望 2", got)
+	}
+}
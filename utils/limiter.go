@@ -0,0 +1,292 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/ashwinyue/wolf-go-adk/telemetry"
+)
+
+// LimitConfig 模型调用限流配置，各项为 0 表示不限制
+type LimitConfig struct {
+	RequestsPerMinute int // 每分钟请求数
+	TokensPerMinute   int // 每分钟 token 数（请求前按字符数估算，返回后按实际用量修正）
+}
+
+// LimitsFromEnv 读取提供方的限流配置：<PROVIDER>_RPM / <PROVIDER>_TPM 优先，其次 LLM_RPM / LLM_TPM
+func LimitsFromEnv(provider string) LimitConfig {
+	prefix := strings.ToUpper(provider) + "_"
+	return LimitConfig{
+		RequestsPerMinute: envInt(prefix+"RPM", envInt("LLM_RPM", 0)),
+		TokensPerMinute:   envInt(prefix+"TPM", envInt("LLM_TPM", 0)),
+	}
+}
+
+// Limiter 某个提供方的限流器：请求数和 token 数各一个令牌桶，另外共享进程级的并发信号量
+type Limiter struct {
+	provider string
+	requests *tokenBucket
+	tokens   *tokenBucket
+}
+
+var (
+	limitersMu sync.Mutex
+	limiters   = make(map[string]*Limiter)
+
+	// globalSem 所有 Agent、所有对局共享的模型调用并发上限（LLM_MAX_CONCURRENCY），为空表示不限制
+	globalSem     chan struct{}
+	globalSemOnce sync.Once
+)
+
+// ProviderLimiter 返回提供方的限流器，同一进程内同一提供方只创建一次，因此并行的多局游戏共享额度
+func ProviderLimiter(provider string) *Limiter {
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+	if l, ok := limiters[provider]; ok {
+		return l
+	}
+	cfg := LimitsFromEnv(provider)
+	l := &Limiter{
+		provider: provider,
+		requests: newTokenBucket(cfg.RequestsPerMinute),
+		tokens:   newTokenBucket(cfg.TokensPerMinute),
+	}
+	limiters[provider] = l
+	return l
+}
+
+// acquire 依次等待并发名额、请求额度和预估的 token 额度，返回实际扣除的 token 数和释放函数
+func (l *Limiter) acquire(ctx context.Context, estimate int) (int, func(), error) {
+	start := time.Now()
+
+	globalSemOnce.Do(func() {
+		if n := envInt("LLM_MAX_CONCURRENCY", 0); n > 0 {
+			globalSem = make(chan struct{}, n)
+		}
+	})
+	release := func() {}
+	if globalSem != nil {
+		select {
+		case globalSem <- struct{}{}:
+			release = func() { <-globalSem }
+		case <-ctx.Done():
+			return 0, nil, ctx.Err()
+		}
+	}
+
+	if _, err := l.requests.wait(ctx, 1); err != nil {
+		release()
+		return 0, nil, err
+	}
+	charged, err := l.tokens.wait(ctx, estimate)
+	if err != nil {
+		release()
+		return 0, nil, err
+	}
+
+	telemetry.ObserveQueueWait(l.provider, time.Since(start))
+	telemetry.AddInflight(1)
+	return charged, func() {
+		telemetry.AddInflight(-1)
+		release()
+	}, nil
+}
+
+// settle 按实际 token 用量修正 acquire 时扣除的额度；charged 是实际扣除的数量，
+// 预估值超过桶容量时只扣除了容量，修正也以此为准
+func (l *Limiter) settle(charged int, usage *schema.TokenUsage) {
+	if usage == nil || usage.TotalTokens == 0 {
+		return
+	}
+	l.tokens.adjust(usage.TotalTokens - charged)
+}
+
+// tokenBucket 令牌桶，容量为每分钟额度，按秒匀速补充；允许修正后余额为负，之后的请求等待补足
+type tokenBucket struct {
+	mu       sync.Mutex
+	capacity float64
+	rate     float64 // 每秒补充的令牌数
+	tokens   float64
+	last     time.Time
+}
+
+func newTokenBucket(perMinute int) *tokenBucket {
+	if perMinute <= 0 {
+		return nil
+	}
+	return &tokenBucket{
+		capacity: float64(perMinute),
+		rate:     float64(perMinute) / 60,
+		tokens:   float64(perMinute),
+		last:     time.Now(),
+	}
+}
+
+// wait 等待 n 个令牌，n 超过容量时按容量计，返回实际扣除的令牌数；不限流时不扣除
+func (b *tokenBucket) wait(ctx context.Context, n int) (int, error) {
+	if b == nil || n <= 0 {
+		return 0, nil
+	}
+	need := math.Min(float64(n), b.capacity)
+	for {
+		b.mu.Lock()
+		b.refill()
+		if b.tokens >= need {
+			b.tokens -= need
+			b.mu.Unlock()
+			return int(need), nil
+		}
+		delay := time.Duration((need - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// adjust 扣除（delta > 0）或返还（delta < 0）令牌
+func (b *tokenBucket) adjust(delta int) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	b.tokens = math.Min(b.tokens-float64(delta), b.capacity)
+}
+
+func (b *tokenBucket) refill() {
+	now := time.Now()
+	b.tokens = math.Min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
+
+// limitedChatModel 在调用底层模型前排队等待限流额度
+type limitedChatModel struct {
+	inner   model.ToolCallingChatModel
+	limiter *Limiter
+}
+
+// WithLimiter 用限流器包装聊天模型，绑定工具后得到的模型共享同一个限流器
+func WithLimiter(cm model.ToolCallingChatModel, limiter *Limiter) model.ToolCallingChatModel {
+	return &limitedChatModel{inner: cm, limiter: limiter}
+}
+
+func (m *limitedChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	charged, release, err := m.limiter.acquire(ctx, estimateTokens(input))
+	if err != nil {
+		return nil, fmt.Errorf("等待模型调用额度失败: %w", err)
+	}
+	defer release()
+
+	msg, err := m.inner.Generate(ctx, input, opts...)
+	if msg != nil && msg.ResponseMeta != nil {
+		m.limiter.settle(charged, msg.ResponseMeta.Usage)
+	}
+	return msg, err
+}
+
+func (m *limitedChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	charged, release, err := m.limiter.acquire(ctx, estimateTokens(input))
+	if err != nil {
+		return nil, fmt.Errorf("等待模型调用额度失败: %w", err)
+	}
+
+	stream, err := m.inner.Stream(ctx, input, opts...)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	// 流读完或出错后才释放并发名额
+	out, w := schema.Pipe[*schema.Message](1)
+	go func() {
+		defer release()
+		defer stream.Close()
+		defer w.Close()
+		var usage *schema.TokenUsage
+		for {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if chunk != nil && chunk.ResponseMeta != nil && chunk.ResponseMeta.Usage != nil {
+				usage = chunk.ResponseMeta.Usage
+			}
+			if closed := w.Send(chunk, err); closed || err != nil {
+				break
+			}
+		}
+		m.limiter.settle(charged, usage)
+	}()
+	return out, nil
+}
+
+func (m *limitedChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	inner, err := m.inner.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return &limitedChatModel{inner: inner, limiter: m.limiter}, nil
+}
+
+// GetType 沿用底层模型的类型，链路和回调中显示真实的模型实现
+func (m *limitedChatModel) GetType() string {
+	typ, _ := components.GetType(m.inner)
+	return typ
+}
+
+// IsCallbacksEnabled 底层模型自己触发回调时，框架不再在包装层重复触发
+func (m *limitedChatModel) IsCallbacksEnabled() bool {
+	return components.IsCallbacksEnabled(m.inner)
+}
+
+// estimateTokens 粗略估算输入 token 数：中文约每字 1 个 token，英文约每 4 个字符 1 个 token，这里按每 2 个字符 1 个 token 折中
+func estimateTokens(input []*schema.Message) int {
+	chars := 0
+	for _, msg := range input {
+		chars += utf8.RuneCountInString(msg.Content)
+	}
+	return chars/2 + 1
+}
+
+func envInt(name string, def int) int {
+	if n, err := strconv.Atoi(os.Getenv(name)); err == nil && n >= 0 {
+		return n
+	}
+	return def
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// usageModel 返回固定 token 用量的聊天模型
+type usageModel struct {
+	total int
+}
+
+func (m *usageModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	msg := schema.AssistantMessage("ok", nil)
+	msg.ResponseMeta = &schema.ResponseMeta{Usage: &schema.TokenUsage{TotalTokens: m.total}}
+	return msg, nil
+}
+
+func (m *usageModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, _ := m.Generate(ctx, input, opts...)
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func (m *usageModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return m, nil
+}
+
+// balance 返回桶中当前的令牌数
+func balance(b *tokenBucket) float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	return b.tokens
+}
+
+// approx 判断令牌数在测试运行期间的补充误差内相等
+func approx(got, want float64) bool {
+	return math.Abs(got-want) < 1
+}
+
+func TestTokenBucketRefillsAtRate(t *testing.T) {
+	b := newTokenBucket(60)
+	if _, err := b.wait(context.Background(), 60); err != nil {
+		t.Fatalf("wait() 失败: %v", err)
+	}
+	if got := balance(b); !approx(got, 0) {
+		t.Fatalf("扣完后余额 = %v，期望 0", got)
+	}
+
+	// 每分钟 60 个即每秒 1 个，拨回 10 秒应补充 10 个
+	b.mu.Lock()
+	b.last = b.last.Add(-10 * time.Second)
+	b.mu.Unlock()
+	if got := balance(b); !approx(got, 10) {
+		t.Errorf("10 秒后余额 = %v，期望 10", got)
+	}
+
+	// 补充不超过容量
+	b.mu.Lock()
+	b.last = b.last.Add(-10 * time.Minute)
+	b.mu.Unlock()
+	if got := balance(b); got != 60 {
+		t.Errorf("长时间空闲后余额 = %v，期望容量 60", got)
+	}
+}
+
+func TestTokenBucketCapsAtCapacity(t *testing.T) {
+	b := newTokenBucket(100)
+	charged, err := b.wait(context.Background(), 1000)
+	if err != nil {
+		t.Fatalf("wait() 失败: %v", err)
+	}
+	if charged != 100 {
+		t.Errorf("超过容量的请求扣除 %d，期望按容量扣除 100", charged)
+	}
+
+	var unlimited *tokenBucket
+	if charged, err := unlimited.wait(context.Background(), 1000); charged != 0 || err != nil {
+		t.Errorf("不限流时 wait() = (%d, %v)，期望 (0, nil)", charged, err)
+	}
+}
+
+func TestTokenBucketWaitHonorsContext(t *testing.T) {
+	b := newTokenBucket(60)
+	if _, err := b.wait(context.Background(), 60); err != nil {
+		t.Fatalf("wait() 失败: %v", err)
+	}
+
+	// 余额为 0 时再取 30 个需要等待约 30 秒
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	charged, err := b.wait(ctx, 30)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("wait() 错误 = %v，期望 %v", err, context.DeadlineExceeded)
+	}
+	if charged != 0 {
+		t.Errorf("取消后扣除 %d，期望 0", charged)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("取消后仍等待了 %v", elapsed)
+	}
+	if got := balance(b); got > 1 {
+		t.Errorf("取消后余额 = %v，期望没有被扣除也没有多出令牌", got)
+	}
+}
+
+func TestLimiterSettlesAgainstCharged(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		estimate int
+		usage    int
+		want     float64 // 修正后的余额
+	}{
+		{"用量多于预估时补扣", 1000, 100, 300, 700},
+		{"用量少于预估时返还", 1000, 300, 100, 900},
+		// 预估 5000 只扣了容量 1000，修正应补扣 200 而不是按预估返还 3800
+		{"预估超过容量时按实际扣除修正", 1000, 5000, 1200, -200},
+		{"没有用量时不修正", 1000, 100, 0, 900},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &Limiter{provider: "test", tokens: newTokenBucket(tt.capacity)}
+			cm := WithLimiter(&usageModel{total: tt.usage}, l)
+
+			// estimateTokens 按每 2 个字符 1 个 token 再加 1 估算
+			content := string(make([]rune, 2*(tt.estimate-1)))
+			if _, err := cm.Generate(context.Background(), []*schema.Message{schema.UserMessage(content)}); err != nil {
+				t.Fatalf("Generate() 失败: %v", err)
+			}
+			if got := balance(l.tokens); !approx(got, tt.want) {
+				t.Errorf("修正后余额 = %v，期望 %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiterSettlesStream(t *testing.T) {
+	l := &Limiter{provider: "test", tokens: newTokenBucket(1000)}
+	cm := WithLimiter(&usageModel{total: 1500}, l)
+
+	stream, err := cm.Stream(context.Background(), []*schema.Message{schema.UserMessage(string(make([]rune, 2*4999)))})
+	if err != nil {
+		t.Fatalf("Stream() 失败: %v", err)
+	}
+	for {
+		if _, err := stream.Recv(); err != nil {
+			break
+		}
+	}
+	stream.Close()
+
+	// settle 在转发协程读完流后执行
+	deadline := time.Now().Add(time.Second)
+	for !approx(balance(l.tokens), -500) && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := balance(l.tokens); !approx(got, -500) {
+		t.Errorf("流式调用修正后余额 = %v，期望 -500", got)
+	}
+}
@@ -19,70 +19,348 @@ package utils
 import (
 	"context"
 	"fmt"
-	"log"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/cloudwego/eino-ext/components/model/ark"
+	"github.com/cloudwego/eino-ext/components/model/claude"
+	"github.com/cloudwego/eino-ext/components/model/gemini"
+	"github.com/cloudwego/eino-ext/components/model/ollama"
 	"github.com/cloudwego/eino-ext/components/model/openai"
 	"github.com/cloudwego/eino/components/model"
+	"google.golang.org/genai"
 )
 
-// MustNewChatModel 创建聊天模型，失败时 panic
-func MustNewChatModel(ctx context.Context) model.ToolCallingChatModel {
-	cm, err := NewChatModel(ctx)
+// ModelConfig 选择模型提供方和模型名称，由调用方显式传入，不修改进程的环境变量，
+// 因此同一进程中的多局游戏可以使用不同的模型；各项为空时读取 MODEL_TYPE 和提供方自己的环境变量
+type ModelConfig struct {
+	Provider string // 提供方名称或别名，为空时读取 MODEL_TYPE
+	Model    string // 模型名称，为空时读取提供方的 ModelEnv
+}
+
+// provider 返回配置选择的模型提供方
+func (c ModelConfig) provider() (Provider, error) {
+	if c.Provider == "" {
+		return CurrentProvider()
+	}
+	p, ok := LookupProvider(c.Provider)
+	if !ok {
+		return Provider{}, fmt.Errorf("不支持的模型提供方 %q，可选: %s", c.Provider, strings.Join(providerNames(), ", "))
+	}
+	return p, nil
+}
+
+// Validate 检查提供方是否存在，以及指定模型名称时提供方是否支持
+func (c ModelConfig) Validate() error {
+	p, err := c.provider()
 	if err != nil {
-		log.Fatalf("Failed to create chat model: %v", err)
-	}
-	return cm
-}
-
-// NewChatModel 创建聊天模型
-// 支持多种模型后端，通过 MODEL_TYPE 环境变量切换：
-//   - "dashscope": 使用阿里云 DashScope (DASHSCOPE_API_KEY, MODEL_NAME)
-//   - 默认: 使用 OpenAI 兼容接口 (OPENAI_API_KEY, OPENAI_MODEL, OPENAI_BASE_URL)
-//
-// 与 eino-examples 最佳实践一致，支持通过环境变量灵活配置模型
-func NewChatModel(ctx context.Context) (model.ToolCallingChatModel, error) {
-	modelType := strings.ToLower(os.Getenv("MODEL_TYPE"))
-
-	switch modelType {
-	case "dashscope":
-		// 阿里云 DashScope (通过 OpenAI 兼容接口)
-		modelName := os.Getenv("MODEL_NAME")
-		if modelName == "" {
-			modelName = "qwen-max"
+		return err
+	}
+	if c.Model != "" && p.ModelEnv == "" {
+		return fmt.Errorf("模型提供方 %s 不支持指定模型名称", p.Name)
+	}
+	return nil
+}
+
+// Name 返回配置使用的模型名称，用于按模型汇总统计；提供方无效时返回空
+func (c ModelConfig) Name() string {
+	p, err := c.provider()
+	if err != nil {
+		return ""
+	}
+	return c.name(p)
+}
+
+func (c ModelConfig) name(p Provider) string {
+	if c.Model != "" {
+		return c.Model
+	}
+	return p.Model()
+}
+
+// Provider 模型提供方，每个提供方通过自己的环境变量配置
+type Provider struct {
+	Name        string
+	Aliases     []string
+	Description string
+	Env         []string                                                                        // 使用的环境变量，用于文档和错误提示；除 API Key 外的取值参与响应缓存键的计算
+	Model       func() string                                                                   // 环境变量中配置的模型名称
+	ModelEnv    string                                                                          // 模型名称所在的环境变量，ModelConfig.Model 不为空时代替它
+	New         func(ctx context.Context, modelName string) (model.ToolCallingChatModel, error) // 创建指定名称的聊天模型
+}
+
+// providers 已注册的模型提供方，按注册顺序排列
+var providers []Provider
+
+// RegisterProvider 注册模型提供方，名称或别名重复时 panic
+func RegisterProvider(p Provider) {
+	for _, name := range append([]string{p.Name}, p.Aliases...) {
+		if _, ok := LookupProvider(name); ok {
+			panic(fmt.Sprintf("模型提供方 %s 重复注册", name))
+		}
+	}
+	providers = append(providers, p)
+}
+
+// LookupProvider 按名称或别名查找模型提供方
+func LookupProvider(name string) (Provider, bool) {
+	name = strings.ToLower(name)
+	for _, p := range providers {
+		if p.Name == name {
+			return p, true
 		}
-		return openai.NewChatModel(ctx, &openai.ChatModelConfig{
-			BaseURL: "https://dashscope.aliyuncs.com/compatible-mode/v1",
-			APIKey:  os.Getenv("DASHSCOPE_API_KEY"),
-			Model:   modelName,
-		})
-
-	default:
-		// OpenAI 兼容接口（默认）
-		apiKey := os.Getenv("OPENAI_API_KEY")
-		modelName := os.Getenv("OPENAI_MODEL")
-		baseURL := os.Getenv("OPENAI_BASE_URL")
-
-		// 兼容旧的 DASHSCOPE 环境变量（向后兼容）
-		if apiKey == "" && os.Getenv("DASHSCOPE_API_KEY") != "" {
-			apiKey = os.Getenv("DASHSCOPE_API_KEY")
-			baseURL = "https://dashscope.aliyuncs.com/compatible-mode/v1"
-			modelName = os.Getenv("MODEL_NAME")
-			if modelName == "" {
-				modelName = "qwen-max"
+		for _, alias := range p.Aliases {
+			if alias == name {
+				return p, true
 			}
 		}
+	}
+	return Provider{}, false
+}
 
-		if apiKey == "" {
-			return nil, fmt.Errorf("no API key configured, set OPENAI_API_KEY or DASHSCOPE_API_KEY")
+// Providers 返回全部已注册的模型提供方
+func Providers() []Provider {
+	return providers
+}
+
+// CurrentProvider 返回 MODEL_TYPE 指定的模型提供方，未设置时使用 openai
+func CurrentProvider() (Provider, error) {
+	name := strings.ToLower(os.Getenv("MODEL_TYPE"))
+	if name == "" {
+		name = "openai"
+	}
+	p, ok := LookupProvider(name)
+	if !ok {
+		return Provider{}, fmt.Errorf("不支持的 MODEL_TYPE=%q，可选: %s", name, strings.Join(providerNames(), ", "))
+	}
+	return p, nil
+}
+
+// settings 返回提供方创建模型时读取的配置（不含 API Key），如接口地址和最大输出长度
+// 这些配置在创建模型时就已确定，不会出现在调用参数中，需要单独参与缓存键的计算
+func (p Provider) settings() map[string]string {
+	s := make(map[string]string)
+	for _, name := range p.Env {
+		if strings.HasSuffix(name, "_API_KEY") {
+			continue
 		}
+		s[name] = os.Getenv(name)
+	}
+	return s
+}
 
-		return openai.NewChatModel(ctx, &openai.ChatModelConfig{
-			BaseURL: baseURL,
-			APIKey:  apiKey,
-			Model:   modelName,
-			ByAzure: os.Getenv("OPENAI_BY_AZURE") == "true",
-		})
+// providerNames 返回全部提供方名称
+func providerNames() []string {
+	names := make([]string, 0, len(providers))
+	for _, p := range providers {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// NewChatModel 按 cfg 创建聊天模型，cfg 各项为空时读取 MODEL_TYPE 和提供方自己的环境变量（见 Providers）
+// 返回的模型经过限流包装，见 LimitsFromEnv 和 LLM_MAX_CONCURRENCY；设置 LLM_CACHE_DIR 时外层再包一层响应缓存，命中时不占用限流额度
+func NewChatModel(ctx context.Context, cfg ModelConfig) (model.ToolCallingChatModel, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	p, _ := cfg.provider()
+	name := cfg.name(p)
+	cm, err := p.New(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("创建 %s 模型失败: %w", p.Name, err)
+	}
+	cm = WithLimiter(cm, ProviderLimiter(p.Name))
+	if cache := SharedResponseCache(); cache != nil {
+		cm = WithResponseCache(cm, cache, p.Name, name, p.settings())
+	}
+	return cm, nil
+}
+
+func init() {
+	RegisterProvider(Provider{
+		Name:        "openai",
+		Description: "OpenAI 兼容接口（默认），也可用于其他兼容 OpenAI 协议的服务",
+		Env:         []string{"OPENAI_API_KEY", "OPENAI_MODEL", "OPENAI_BASE_URL", "OPENAI_BY_AZURE"},
+		Model: func() string {
+			if os.Getenv("OPENAI_API_KEY") == "" && os.Getenv("DASHSCOPE_API_KEY") != "" {
+				return dashscopeModel()
+			}
+			return os.Getenv("OPENAI_MODEL")
+		},
+		ModelEnv: "OPENAI_MODEL",
+		New:      newOpenAIModel,
+	})
+	RegisterProvider(Provider{
+		Name:        "dashscope",
+		Description: "阿里云 DashScope（通过 OpenAI 兼容接口）",
+		Env:         []string{"DASHSCOPE_API_KEY", "MODEL_NAME"},
+		Model:       dashscopeModel,
+		ModelEnv:    "MODEL_NAME",
+		New: func(ctx context.Context, modelName string) (model.ToolCallingChatModel, error) {
+			if err := requireEnv("dashscope", "DASHSCOPE_API_KEY"); err != nil {
+				return nil, err
+			}
+			return openai.NewChatModel(ctx, &openai.ChatModelConfig{
+				BaseURL: dashscopeBaseURL,
+				APIKey:  os.Getenv("DASHSCOPE_API_KEY"),
+				Model:   modelName,
+			})
+		},
+	})
+	RegisterProvider(Provider{
+		Name:        "ark",
+		Aliases:     []string{"volcengine", "doubao"},
+		Description: "火山引擎方舟",
+		Env:         []string{"ARK_API_KEY", "ARK_MODEL", "ARK_BASE_URL"},
+		Model:       func() string { return os.Getenv("ARK_MODEL") },
+		ModelEnv:    "ARK_MODEL",
+		New: func(ctx context.Context, modelName string) (model.ToolCallingChatModel, error) {
+			if err := requireEnv("ark", "ARK_API_KEY"); err != nil {
+				return nil, err
+			}
+			if err := requireModel("ark", "ARK_MODEL", modelName); err != nil {
+				return nil, err
+			}
+			return ark.NewChatModel(ctx, &ark.ChatModelConfig{
+				BaseURL: os.Getenv("ARK_BASE_URL"),
+				APIKey:  os.Getenv("ARK_API_KEY"),
+				Model:   modelName,
+			})
+		},
+	})
+	RegisterProvider(Provider{
+		Name:        "ollama",
+		Aliases:     []string{"local"},
+		Description: "本地 Ollama，无需 API Key",
+		Env:         []string{"OLLAMA_MODEL", "OLLAMA_BASE_URL"},
+		Model:       func() string { return os.Getenv("OLLAMA_MODEL") },
+		ModelEnv:    "OLLAMA_MODEL",
+		New: func(ctx context.Context, modelName string) (model.ToolCallingChatModel, error) {
+			if err := requireModel("ollama", "OLLAMA_MODEL", modelName); err != nil {
+				return nil, err
+			}
+			baseURL := os.Getenv("OLLAMA_BASE_URL")
+			if baseURL == "" {
+				baseURL = "http://localhost:11434"
+			}
+			return ollama.NewChatModel(ctx, &ollama.ChatModelConfig{
+				BaseURL: baseURL,
+				Model:   modelName,
+			})
+		},
+	})
+	RegisterProvider(Provider{
+		Name:        "claude",
+		Aliases:     []string{"anthropic"},
+		Description: "Anthropic Claude，设置 ANTHROPIC_BASE_URL 可接入兼容 Anthropic 协议的服务",
+		Env:         []string{"ANTHROPIC_API_KEY", "ANTHROPIC_MODEL", "ANTHROPIC_BASE_URL", "ANTHROPIC_MAX_TOKENS"},
+		Model:       func() string { return os.Getenv("ANTHROPIC_MODEL") },
+		ModelEnv:    "ANTHROPIC_MODEL",
+		New: func(ctx context.Context, modelName string) (model.ToolCallingChatModel, error) {
+			if err := requireEnv("claude", "ANTHROPIC_API_KEY"); err != nil {
+				return nil, err
+			}
+			if err := requireModel("claude", "ANTHROPIC_MODEL", modelName); err != nil {
+				return nil, err
+			}
+			maxTokens := 4096
+			if n, err := strconv.Atoi(os.Getenv("ANTHROPIC_MAX_TOKENS")); err == nil && n > 0 {
+				maxTokens = n
+			}
+			cfg := &claude.Config{
+				APIKey:    os.Getenv("ANTHROPIC_API_KEY"),
+				Model:     modelName,
+				MaxTokens: maxTokens,
+			}
+			if baseURL := os.Getenv("ANTHROPIC_BASE_URL"); baseURL != "" {
+				cfg.BaseURL = &baseURL
+			}
+			return claude.NewChatModel(ctx, cfg)
+		},
+	})
+	RegisterProvider(Provider{
+		Name:        "gemini",
+		Aliases:     []string{"google"},
+		Description: "Google Gemini（Gemini API）",
+		Env:         []string{"GEMINI_API_KEY", "GEMINI_MODEL"},
+		Model:       func() string { return os.Getenv("GEMINI_MODEL") },
+		ModelEnv:    "GEMINI_MODEL",
+		New: func(ctx context.Context, modelName string) (model.ToolCallingChatModel, error) {
+			if err := requireEnv("gemini", "GEMINI_API_KEY"); err != nil {
+				return nil, err
+			}
+			if err := requireModel("gemini", "GEMINI_MODEL", modelName); err != nil {
+				return nil, err
+			}
+			client, err := genai.NewClient(ctx, &genai.ClientConfig{
+				APIKey:  os.Getenv("GEMINI_API_KEY"),
+				Backend: genai.BackendGeminiAPI,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("创建 Gemini 客户端失败: %w", err)
+			}
+			return gemini.NewChatModel(ctx, &gemini.Config{
+				Client: client,
+				Model:  modelName,
+			})
+		},
+	})
+}
+
+// dashscopeBaseURL DashScope 的 OpenAI 兼容地址
+const dashscopeBaseURL = "https://dashscope.aliyuncs.com/compatible-mode/v1"
+
+func dashscopeModel() string {
+	if name := os.Getenv("MODEL_NAME"); name != "" {
+		return name
+	}
+	return "qwen-max"
+}
+
+// newOpenAIModel OpenAI 兼容接口，未设置 OPENAI_API_KEY 但设置了 DASHSCOPE_API_KEY 时兼容旧配置走 DashScope
+// （此时环境变量中的模型名称来自 MODEL_NAME，见 Provider.Model）
+func newOpenAIModel(ctx context.Context, modelName string) (model.ToolCallingChatModel, error) {
+	apiKey := os.Getenv("OPENAI_API_KEY")
+	baseURL := os.Getenv("OPENAI_BASE_URL")
+
+	// 兼容旧的 DASHSCOPE 环境变量（向后兼容）
+	if apiKey == "" && os.Getenv("DASHSCOPE_API_KEY") != "" {
+		apiKey = os.Getenv("DASHSCOPE_API_KEY")
+		baseURL = dashscopeBaseURL
+	}
+
+	if apiKey == "" {
+		return nil, fmt.Errorf("no API key configured, set OPENAI_API_KEY or DASHSCOPE_API_KEY")
+	}
+
+	return openai.NewChatModel(ctx, &openai.ChatModelConfig{
+		BaseURL: baseURL,
+		APIKey:  apiKey,
+		Model:   modelName,
+		ByAzure: os.Getenv("OPENAI_BY_AZURE") == "true",
+	})
+}
+
+// requireModel 检查提供方是否配置了模型名称
+func requireModel(provider, env, modelName string) error {
+	if modelName == "" {
+		return fmt.Errorf("%s 需要通过 %s 或 -model 指定模型名称", provider, env)
+	}
+	return nil
+}
+
+// requireEnv 检查提供方必需的环境变量
+func requireEnv(provider string, names ...string) error {
+	var missing []string
+	for _, name := range names {
+		if os.Getenv(name) == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s 需要设置 %s", provider, strings.Join(missing, ", "))
 	}
+	return nil
 }
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+)
+
+// testProviderModel 测试提供方最近一次创建模型时收到的模型名称
+var testProviderModel string
+
+func init() {
+	RegisterProvider(Provider{
+		Name:     "test-provider",
+		Model:    func() string { return os.Getenv("TEST_PROVIDER_MODEL") },
+		ModelEnv: "TEST_PROVIDER_MODEL",
+		New: func(ctx context.Context, modelName string) (model.ToolCallingChatModel, error) {
+			testProviderModel = modelName
+			if modelName == "broken" {
+				return nil, errors.New("boom")
+			}
+			return &countingModel{}, nil
+		},
+	})
+	RegisterProvider(Provider{
+		Name:  "fixed-provider",
+		Model: func() string { return "fixed" },
+		New: func(ctx context.Context, modelName string) (model.ToolCallingChatModel, error) {
+			return &countingModel{}, nil
+		},
+	})
+}
+
+func TestModelConfigName(t *testing.T) {
+	t.Setenv("MODEL_TYPE", "test-provider")
+	t.Setenv("TEST_PROVIDER_MODEL", "from-env")
+
+	tests := []struct {
+		name string
+		cfg  ModelConfig
+		want string
+	}{
+		{"读取环境变量", ModelConfig{}, "from-env"},
+		{"指定模型名称", ModelConfig{Model: "from-flag"}, "from-flag"},
+		{"指定提供方", ModelConfig{Provider: "fixed-provider"}, "fixed"},
+		{"提供方无效", ModelConfig{Provider: "nope"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.Name(); got != tt.want {
+				t.Errorf("Name() = %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModelConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     ModelConfig
+		wantErr bool
+	}{
+		{"有效", ModelConfig{Provider: "test-provider", Model: "m"}, false},
+		{"提供方无效", ModelConfig{Provider: "nope"}, true},
+		{"提供方不支持指定模型", ModelConfig{Provider: "fixed-provider", Model: "m"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() 错误 = %v，期望出错 %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewChatModelUsesConfigWithoutTouchingEnv(t *testing.T) {
+	t.Setenv("MODEL_TYPE", "openai")
+	t.Setenv("TEST_PROVIDER_MODEL", "from-env")
+	DisableResponseCache()
+
+	if _, err := NewChatModel(context.Background(), ModelConfig{Provider: "test-provider", Model: "from-flag"}); err != nil {
+		t.Fatalf("NewChatModel() 失败: %v", err)
+	}
+	if testProviderModel != "from-flag" {
+		t.Errorf("提供方收到模型名称 %q，期望 from-flag", testProviderModel)
+	}
+	if got := os.Getenv("MODEL_TYPE"); got != "openai" {
+		t.Errorf("MODEL_TYPE 被改为 %q", got)
+	}
+	if got := os.Getenv("TEST_PROVIDER_MODEL"); got != "from-env" {
+		t.Errorf("TEST_PROVIDER_MODEL 被改为 %q", got)
+	}
+
+	// 创建失败时返回错误而不是退出进程，调用方可以只放弃这一局
+	if _, err := NewChatModel(context.Background(), ModelConfig{Provider: "test-provider", Model: "broken"}); err == nil {
+		t.Error("提供方创建失败时 NewChatModel() 没有返回错误")
+	}
+	if _, err := NewChatModel(context.Background(), ModelConfig{Provider: "nope"}); err == nil {
+		t.Error("提供方无效时 NewChatModel() 没有返回错误")
+	}
+}
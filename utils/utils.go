@@ -17,7 +17,11 @@
 package utils
 
 import (
+	"cmp"
 	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
 	"strings"
 )
 
@@ -31,24 +35,46 @@ func Truncate(s string, maxLen int) string {
 	return s
 }
 
-// MajorityVote 多数投票
-func MajorityVote(votes map[string]string) (string, string) {
+// MajorityVote 统计票数，返回得票最多的目标、并列最高票的目标和票型
+// order 为目标的排列顺序（通常是座位顺序），票型按得票从多到少、同票按 order 排列，不在 order 中的目标排在最后；
+// 最高票有多名目标时 tied 按同样顺序列出它们，由 rng 从中随机选出 winner，rng 为空时取最靠前的一名；没有平票时 tied 为空
+func MajorityVote(votes map[string]string, order []string, rng *rand.Rand) (winner string, tied []string, tally string) {
 	counts := make(map[string]int)
 	for _, target := range votes {
 		counts[target]++
 	}
+	if len(counts) == 0 {
+		return "", nil, ""
+	}
 
-	var maxCount int
-	var winner string
-	var details []string
+	rank := func(target string) int {
+		if i := slices.Index(order, target); i >= 0 {
+			return i
+		}
+		return len(order)
+	}
+	targets := slices.Collect(maps.Keys(counts))
+	slices.SortFunc(targets, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(counts[b], counts[a]),
+			cmp.Compare(rank(a), rank(b)),
+			cmp.Compare(a, b),
+		)
+	})
 
-	for target, count := range counts {
-		details = append(details, fmt.Sprintf("%s:%d", target, count))
-		if count > maxCount {
-			maxCount = count
-			winner = target
+	details := make([]string, len(targets))
+	for i, target := range targets {
+		details[i] = fmt.Sprintf("%s:%d", target, counts[target])
+		if counts[target] == counts[targets[0]] {
+			tied = append(tied, target)
 		}
 	}
 
-	return winner, strings.Join(details, ", ")
+	winner = tied[0]
+	if len(tied) == 1 {
+		tied = nil
+	} else if rng != nil {
+		winner = tied[rng.Intn(len(tied))]
+	}
+	return winner, tied, strings.Join(details, ", ")
 }
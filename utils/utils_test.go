@@ -0,0 +1,69 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestMajorityVote(t *testing.T) {
+	order := []string{"Player1", "Player2", "Player3", "Player10"}
+	tests := []struct {
+		name   string
+		votes  map[string]string
+		winner string
+		tied   []string
+		tally  string
+	}{
+		{"没有票", nil, "", nil, ""},
+		{"多数", map[string]string{"Player1": "Player3", "Player2": "Player3", "Player3": "Player10"}, "Player3", nil, "Player3:2, Player10:1"},
+		{"票型按得票和座位排序", map[string]string{"Player1": "Player10", "Player2": "Player1", "Player3": "Player2", "Player10": "Player2"}, "Player2", nil, "Player2:2, Player1:1, Player10:1"},
+		{"平票取最靠前的座位", map[string]string{"Player1": "Player10", "Player2": "Player3", "Player3": "Player10", "Player10": "Player3"}, "Player3", []string{"Player3", "Player10"}, "Player3:2, Player10:2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				winner, tied, tally := MajorityVote(tt.votes, order, nil)
+				if winner != tt.winner || !slices.Equal(tied, tt.tied) || tally != tt.tally {
+					t.Fatalf("MajorityVote() = (%q, %v, %q)，期望 (%q, %v, %q)", winner, tied, tally, tt.winner, tt.tied, tt.tally)
+				}
+			}
+		})
+	}
+}
+
+func TestMajorityVoteSeededTieBreak(t *testing.T) {
+	votes := map[string]string{"Player1": "Player2", "Player2": "Player1", "Player3": "Player3", "Player4": "Player4"}
+	order := []string{"Player1", "Player2", "Player3", "Player4"}
+
+	seen := make(map[string]bool)
+	for seed := int64(1); seed <= 50; seed++ {
+		first, tied, _ := MajorityVote(votes, order, rand.New(rand.NewSource(seed)))
+		if !slices.Equal(tied, order) {
+			t.Fatalf("平票 = %v，期望 %v", tied, order)
+		}
+		if again, _, _ := MajorityVote(votes, order, rand.New(rand.NewSource(seed))); again != first {
+			t.Fatalf("种子 %d 两次抽中 %q 和 %q，期望相同", seed, first, again)
+		}
+		seen[first] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("50 个种子都抽中 %v，期望随种子变化", seen)
+	}
+}